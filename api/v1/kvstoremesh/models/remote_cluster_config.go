@@ -39,6 +39,9 @@ type RemoteClusterConfig struct {
 
 	// Whether the remote cluster supports per-prefix "synced" canaries
 	SyncCanaries bool `json:"sync-canaries,omitempty"`
+
+	// The non-default UDP port on which the tunnel endpoints of the remote cluster listen (0 means default)
+	TunnelPort int64 `json:"tunnel-port,omitempty"`
 }
 
 // Validate validates this remote cluster config
@@ -340,6 +340,10 @@ func init() {
         "sync-canaries": {
           "description": "Whether the remote cluster supports per-prefix \"synced\" canaries",
           "type": "boolean"
+        },
+        "tunnel-port": {
+          "description": "The non-default UDP port on which the tunnel endpoints of the remote cluster listen (0 means default)",
+          "type": "integer"
         }
       }
     },
@@ -4745,6 +4745,10 @@ func init() {
         "sync-canaries": {
           "description": "Whether the remote cluster supports per-prefix \"synced\" canaries",
           "type": "boolean"
+        },
+        "tunnel-port": {
+          "description": "The non-default UDP port on which the tunnel endpoints of the remote cluster listen (0 means default)",
+          "type": "integer"
         }
       }
     },
@@ -10926,6 +10930,10 @@ func init() {
         "sync-canaries": {
           "description": "Whether the remote cluster supports per-prefix \"synced\" canaries",
           "type": "boolean"
+        },
+        "tunnel-port": {
+          "description": "The non-default UDP port on which the tunnel endpoints of the remote cluster listen (0 means default)",
+          "type": "integer"
         }
       }
     },
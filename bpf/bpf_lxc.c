@@ -1953,6 +1953,15 @@ ipv4_policy(struct __ctx_buff *ctx, struct iphdr *ip4, __u32 src_label,
 		if (ret == CT_NEW && ip4->saddr == IPV4_LOOPBACK &&
 		    ct_has_loopback_egress_entry4(get_ct_map4(tuple), tuple)) {
 			ct_state_new.loopback = true;
+			/* Policy enforcement is bypassed, but emit a verdict
+			 * notification so that the implicitly allowed loopback
+			 * connection remains visible to audits.
+			 */
+			send_policy_verdict_notify(ctx, src_label, tuple->dport,
+						   tuple->nexthdr, POLICY_INGRESS, 0,
+						   CTX_ACT_OK, 0,
+						   POLICY_MATCH_IMPLICIT_LOOPBACK,
+						   0, 0);
 			break;
 		}
 
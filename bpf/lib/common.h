@@ -370,7 +370,8 @@ struct metrics_key {
 		  pad:6;
 	__u16	  line;		/* __MAGIC_LINE__ */
 	__u8	  file;		/* __MAGIC_FILE__, needs to fit __id_for_file */
-	__u8	  reserved[3];	/* reserved for future extension */
+	__u8	  reserved;	/* reserved for future extension */
+	__u16	  epid;		/* endpoint ID, 0 if not known */
 };
 
 
@@ -460,6 +461,7 @@ enum {
 	POLICY_MATCH_ALL = 4,
 	POLICY_MATCH_L3_PROTO = 5,
 	POLICY_MATCH_PROTO_ONLY = 6,
+	POLICY_MATCH_IMPLICIT_LOOPBACK = 7,
 };
 
 enum {
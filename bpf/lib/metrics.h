@@ -20,6 +20,15 @@ struct {
 	__uint(map_flags, CONDITIONAL_PREALLOC);
 } cilium_metrics __section_maps_btf;
 
+/* Endpoint programs account metrics against their own endpoint ID, all other
+ * programs fall back to 0 (not known).
+ */
+#ifdef LXC_ID
+#define METRICS_EP_ID LXC_ID
+#else
+#define METRICS_EP_ID 0
+#endif
+
 /**
  * update_metrics
  * @direction:	1: Ingress 2: Egress
@@ -40,6 +49,7 @@ static __always_inline void _update_metrics(__u64 bytes, __u8 direction,
 	key.dir    = direction;
 	key.line   = line;
 	key.file   = file;
+	key.epid   = METRICS_EP_ID;
 
 	entry = map_lookup_elem(&cilium_metrics, &key);
 	if (entry) {
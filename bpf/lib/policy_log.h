@@ -30,7 +30,7 @@ struct policy_verdict_notify {
 		ipv6:1,
 		match_type:3,
 		audited:1,
-		pad0:1;
+		l3_dev:1;
 	__u8	auth_type;
 	__u8	pad1; /* align with 64 bits */
 	__u16	pad2; /* align with 64 bits */
@@ -103,6 +103,7 @@ send_policy_verdict_notify(struct __ctx_buff *ctx, __u32 remote_label, __u16 dst
 		.proto		= proto,
 		.dir		= dir,
 		.ipv6		= is_ipv6,
+		.l3_dev		= ETH_HLEN == 0,
 		.audited	= is_audited,
 		.auth_type      = auth_type,
 	};
@@ -8,6 +8,7 @@
 
 #define RATELIMIT_USAGE_ICMPV6 1
 #define RATELIMIT_USAGE_EVENTS_MAP 2
+#define RATELIMIT_USAGE_SERVICE 3
 
 struct ratelimit_key {
 	__u32 usage;
@@ -15,6 +16,9 @@ struct ratelimit_key {
 		struct {
 			__u32 netdev_idx;
 		} icmpv6;
+		struct {
+			__u32 rev_nat_id;
+		} service;
 	} key;
 };
 
@@ -19,6 +19,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/cilium/workerpool"
@@ -133,6 +134,12 @@ type Options struct {
 	TetragonNamespace string
 	// Retry limit for copying files from pods
 	CopyRetryLimit int
+	// Case-insensitive patterns matched against task descriptions; when
+	// non-empty, only matching tasks are run.
+	TaskFilter []string
+	// The limit on the total number of bytes written to the sysdump. Once
+	// exceeded, remaining files are skipped. 0 means no limit.
+	SizeLimitBytes int64
 }
 
 // Task defines a task for the sysdump collector to execute.
@@ -159,6 +166,9 @@ type Collector struct {
 	// subtasksWg is used to wait for subtasks to be submitted to the pool before calling 'Drain'.
 	// It is required since we don't know beforehand how many sub-tasks will be created, as they depend on the number of Cilium/Hubble/... pods found by "main" tasks.
 	subtasksWg sync.WaitGroup
+	// bytesWritten tracks the total number of bytes written to the sysdump,
+	// used to enforce Options.SizeLimitBytes.
+	bytesWritten atomic.Int64
 	// startTime keeps track of the time this sysdump collector got initialized. This timestamp
 	// is used to substitute '<ts>' in filenames.
 	startTime time.Time
@@ -413,18 +423,36 @@ func (c *Collector) AbsoluteTempPath(f string) string {
 }
 
 func (c *Collector) WithFileSink(filename string, fn func(io.Writer) error) error {
+	if c.Options.SizeLimitBytes > 0 && c.bytesWritten.Load() >= c.Options.SizeLimitBytes {
+		c.logWarn("Skipping %q: sysdump size limit of %d bytes exceeded", filename, c.Options.SizeLimitBytes)
+		return nil
+	}
+
 	path := c.AbsoluteTempPath(filename)
 	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, fileMode)
 	if err != nil {
 		return err
 	}
 
+	cw := &countingWriter{w: file, n: &c.bytesWritten}
 	return errors.Join(
-		fn(file),
+		fn(cw),
 		file.Close(),
 	)
 }
 
+// countingWriter accumulates the number of written bytes into n.
+type countingWriter struct {
+	w io.Writer
+	n *atomic.Int64
+}
+
+func (cw *countingWriter) Write(p []byte) (int, error) {
+	n, err := cw.w.Write(p)
+	cw.n.Add(int64(n))
+	return n, err
+}
+
 // WriteYAML writes a kubernetes object to a file as YAML.
 func (c *Collector) WriteYAML(filename string, o runtime.Object) error {
 	return c.WithFileSink(filename, func(w io.Writer) error {
@@ -2214,7 +2242,18 @@ func (c *Collector) logWarn(msg string, args ...any) {
 }
 
 func (c *Collector) shouldSkipTask(t Task) bool {
-	return c.Options.Quick && !t.Quick
+	if c.Options.Quick && !t.Quick {
+		return true
+	}
+	if len(c.Options.TaskFilter) == 0 {
+		return false
+	}
+	for _, pattern := range c.Options.TaskFilter {
+		if strings.Contains(strings.ToLower(t.Description), strings.ToLower(pattern)) {
+			return false
+		}
+	}
+	return true
 }
 
 func (c *Collector) SubmitTetragonBugtoolTasks(pods []*corev1.Pod, tetragonAgentContainerName,
@@ -3272,6 +3311,12 @@ func InitSysdumpFlags(cmd *cobra.Command, options *Options, optionPrefix string,
 	cmd.Flags().Int64Var(&options.LogsLimitBytes,
 		optionPrefix+"logs-limit-bytes", DefaultLogsLimitBytes,
 		"The limit on the number of bytes to retrieve when collecting logs")
+	cmd.Flags().Int64Var(&options.SizeLimitBytes,
+		optionPrefix+"size-limit-bytes", 0,
+		"The limit on the total number of bytes written to the sysdump, remaining files are skipped once exceeded (0 means no limit)")
+	cmd.Flags().StringSliceVar(&options.TaskFilter,
+		optionPrefix+"tasks", nil,
+		"Case-insensitive patterns matched against task descriptions, only matching tasks are run (empty means all tasks)")
 	cmd.Flags().DurationVar(&options.LogsSinceTime,
 		optionPrefix+"logs-since-time", DefaultLogsSinceTime,
 		"How far back in time to go when collecting logs")
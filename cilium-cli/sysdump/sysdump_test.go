@@ -11,6 +11,7 @@ import (
 	"net/http"
 	"os"
 	"path"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
@@ -696,3 +697,33 @@ func Test_removeTopDirectory(t *testing.T) {
 	_, err = removeTopDirectory("")
 	assert.Error(t, err)
 }
+
+func TestShouldSkipTask(t *testing.T) {
+	c := Collector{Options: Options{}}
+	assert.False(t, c.shouldSkipTask(Task{Description: "Collect Kubernetes nodes"}))
+
+	c.Options.Quick = true
+	assert.True(t, c.shouldSkipTask(Task{Description: "Collect Kubernetes nodes"}))
+	assert.False(t, c.shouldSkipTask(Task{Description: "Collect Kubernetes nodes", Quick: true}))
+
+	c.Options.Quick = false
+	c.Options.TaskFilter = []string{"hubble", "Envoy"}
+	assert.False(t, c.shouldSkipTask(Task{Description: "Collect Hubble flows"}))
+	assert.False(t, c.shouldSkipTask(Task{Description: "Collecting Cilium Envoy config"}))
+	assert.True(t, c.shouldSkipTask(Task{Description: "Collect Kubernetes nodes"}))
+}
+
+func TestWithFileSinkSizeLimit(t *testing.T) {
+	c := Collector{
+		Options:    Options{Writer: io.Discard, SizeLimitBytes: 8},
+		sysdumpDir: t.TempDir(),
+	}
+	c.logWriter = io.Discard
+
+	assert.NoError(t, c.WriteString("first", "0123456789"))
+	assert.FileExists(t, filepath.Join(c.sysdumpDir, "first"))
+
+	// the limit is now exhausted, further files must be skipped
+	assert.NoError(t, c.WriteString("second", "x"))
+	assert.NoFileExists(t, filepath.Join(c.sysdumpDir, "second"))
+}
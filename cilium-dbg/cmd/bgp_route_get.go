@@ -21,11 +21,13 @@ import (
 const (
 	availableRoutesKW  = "available"
 	advertisedRoutesKW = "advertised"
+	receivedRoutesKW   = "received"
 	vRouterKW          = "vrouter"
 	peerKW             = "peer"
 	neighborKW         = "neighbor"
 
 	locRIBTableType    = "loc-rib"
+	adjRIBInTableType  = "adj-rib-in"
 	adjRIBOutTableType = "adj-rib-out"
 
 	ipv4AFI     = "ipv4"
@@ -33,7 +35,7 @@ const (
 )
 
 var BgpRoutesCmd = &cobra.Command{
-	Use:   "routes <available | advertised> <afi> <safi> [vrouter <asn>] [peer|neighbor <address>]",
+	Use:   "routes <available | advertised | received> <afi> <safi> [vrouter <asn>] [peer|neighbor <address>]",
 	Short: "List routes in the BGP Control Plane's RIBs",
 	Long:  "List routes in the BGP Control Plane's Routing Information Bases (RIBs)",
 	Example: `  Get all IPv4 unicast routes available:
@@ -43,7 +45,10 @@ var BgpRoutesCmd = &cobra.Command{
     cilium-dbg bgp routes available ipv6 unicast vrouter 65001
 
   Get IPv4 unicast routes advertised to a specific peer:
-    cilium-dbg bgp routes advertised ipv4 unicast peer 10.0.0.1`,
+    cilium-dbg bgp routes advertised ipv4 unicast peer 10.0.0.1
+
+  Get IPv4 unicast routes received from a specific peer:
+    cilium-dbg bgp routes received ipv4 unicast peer 10.0.0.1`,
 
 	Run: func(cmd *cobra.Command, args []string) {
 		var err error
@@ -66,7 +71,7 @@ var BgpRoutesCmd = &cobra.Command{
 		}
 
 		// parse [peer|neighbor <address>]
-		if params.TableType == adjRIBOutTableType && len(args) > 0 {
+		if (params.TableType == adjRIBInTableType || params.TableType == adjRIBOutTableType) && len(args) > 0 {
 			addr, err := parseBGPPeerAddr(args)
 			if err != nil {
 				Fatalf("failed to parse peer address: %s\n", err)
@@ -90,8 +95,9 @@ var BgpRoutesCmd = &cobra.Command{
 				Fatalf("failed getting output in JSON: %s\n", err)
 			}
 		} else {
-			// print peer addresses for `advertised` routes without specifying a peer
-			printPeer := (params.TableType == adjRIBOutTableType) && (params.Neighbor == nil || *params.Neighbor == "")
+			// print peer addresses for `advertised` / `received` routes without specifying a peer
+			printPeer := (params.TableType == adjRIBInTableType || params.TableType == adjRIBOutTableType) &&
+				(params.Neighbor == nil || *params.Neighbor == "")
 			w := NewTabWriter()
 			if err := api.PrintBGPRoutesTable(w, res.GetPayload(), printPeer, true); err != nil {
 				Fatalf("failed printing BGP routes: %s\n", err)
@@ -112,8 +118,10 @@ func parseBGPRoutesMandatoryArgs(args []string, silent bool) (tableType, afi, sa
 		tableType = locRIBTableType
 	case advertisedRoutesKW:
 		tableType = adjRIBOutTableType
+	case receivedRoutesKW:
+		tableType = adjRIBInTableType
 	default:
-		err = fmt.Errorf("invalid table type discriminator `%s` (should be `%s` / `%s`)", args[0], availableRoutesKW, advertisedRoutesKW)
+		err = fmt.Errorf("invalid table type discriminator `%s` (should be `%s` / `%s` / `%s`)", args[0], availableRoutesKW, advertisedRoutesKW, receivedRoutesKW)
 		return
 	}
 
@@ -25,6 +25,7 @@ const (
 	bytesTitle     = "BYTES"
 	lineTitle      = "LINE"
 	fileTitle      = "FILE"
+	endpointTitle  = "ENDPOINT"
 )
 
 type metricsRow struct {
@@ -35,6 +36,7 @@ type metricsRow struct {
 	bytes      uint64
 	line       uint16
 	file       string
+	endpoint   uint16
 }
 
 type jsonMetric struct {
@@ -44,6 +46,7 @@ type jsonMetric struct {
 	Bytes     uint64 `json:"bytes"`
 	Line      uint16 `json:"line"`
 	File      string `json:"file"`
+	Endpoint  uint16 `json:"endpoint"`
 }
 
 type jsonMetrics []*jsonMetric
@@ -93,6 +96,7 @@ func listJSONMetrics(bpfMetricsList []*metricsRow) {
 		direction string
 		line      uint16
 		file      string
+		endpoint  uint16
 	}
 
 	metrics := make(map[key]*jsonMetric)
@@ -103,6 +107,7 @@ func listJSONMetrics(bpfMetricsList []*metricsRow) {
 			direction: strings.ToLower(row.direction),
 			line:      row.line,
 			file:      row.file,
+			endpoint:  row.endpoint,
 		}
 
 		if _, ok := metrics[k]; !ok {
@@ -111,6 +116,7 @@ func listJSONMetrics(bpfMetricsList []*metricsRow) {
 				Direction: strings.ToLower(row.direction),
 				Line:      row.line,
 				File:      row.file,
+				Endpoint:  row.endpoint,
 			}
 		}
 
@@ -136,13 +142,13 @@ func listHumanReadableMetrics(bpfMetricsList []*metricsRow) {
 	}
 
 	w := tabwriter.NewWriter(os.Stdout, 5, 0, 3, ' ', 0)
-	fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", reasonTitle, directionTitle, packetsTitle, bytesTitle, lineTitle, fileTitle)
+	fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", reasonTitle, directionTitle, packetsTitle, bytesTitle, lineTitle, fileTitle, endpointTitle)
 
-	const numColumns = 6
+	const numColumns = 7
 	rows := [][numColumns]string{}
 
 	for _, row := range bpfMetricsList {
-		rows = append(rows, [numColumns]string{row.reasonDesc, row.direction, fmt.Sprintf("%d", row.packets), fmt.Sprintf("%d", row.bytes), fmt.Sprintf("%d", row.line), row.file})
+		rows = append(rows, [numColumns]string{row.reasonDesc, row.direction, fmt.Sprintf("%d", row.packets), fmt.Sprintf("%d", row.bytes), fmt.Sprintf("%d", row.line), row.file, fmt.Sprintf("%d", row.endpoint)})
 	}
 
 	sort.Slice(rows, func(i, j int) bool {
@@ -158,7 +164,7 @@ func listHumanReadableMetrics(bpfMetricsList []*metricsRow) {
 	})
 
 	for _, r := range rows {
-		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", r[0], r[1], r[2], r[3], r[4], r[5])
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", r[0], r[1], r[2], r[3], r[4], r[5], r[6])
 	}
 
 	w.Flush()
@@ -173,6 +179,7 @@ func extractRow(key *metricsmap.Key, values *metricsmap.Values) *metricsRow {
 		values.Bytes(),
 		key.Line,
 		key.FileName(),
+		key.EpID,
 	}
 }
 
@@ -432,7 +432,8 @@ func startServer(
 	)
 
 	config := cmtypes.CiliumClusterConfig{
-		ID: cinfo.ID,
+		ID:         cinfo.ID,
+		TunnelPort: cinfo.TunnelPort,
 		Capabilities: cmtypes.CiliumClusterConfigCapabilities{
 			SyncedCanaries:        true,
 			MaxConnectedClusters:  cinfo.MaxConnectedClusters,
@@ -12,6 +12,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/tidwall/gjson"
 
+	"github.com/cilium/cilium/api/v1/models"
 	"github.com/cilium/cilium/pkg/logging"
 )
 
@@ -263,6 +264,33 @@ func TestCleanupOtherCNI(t *testing.T) {
 	})
 }
 
+func TestOtherCNIConfigsStatus(t *testing.T) {
+	workdir := t.TempDir()
+	//exhaustruct:ignore
+	cfg := Config{
+		CNILogFile:            `/opt"/cni.log`,
+		CNIChainingMode:       "none",
+		CNIExclusive:          false,
+		WriteCNIConfWhenReady: path.Join(workdir, "05-cilium.conflist"),
+	}
+	c := newConfigManager(logging.DefaultLogger, cfg, false)
+
+	touch(t, workdir, "10-other.conflist")
+
+	err := c.setupCNIConfFile()
+	assert.NoError(t, err)
+
+	status := c.Status()
+	assert.Equal(t, models.StatusStateWarning, status.State)
+	assert.Contains(t, status.Msg, "10-other.conflist")
+
+	// removing the conflicting configuration restores the Ok status
+	assert.NoError(t, os.Remove(path.Join(workdir, "10-other.conflist")))
+	err = c.setupCNIConfFile()
+	assert.NoError(t, err)
+	assert.Equal(t, models.StatusStateOk, c.Status().State)
+}
+
 func touch(t *testing.T, dir, name string) {
 	f, err := os.Create(path.Join(dir, name))
 	assert.NoError(t, err)
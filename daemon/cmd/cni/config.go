@@ -281,6 +281,12 @@ func (c *cniConfigManager) setupCNIConfFile() (err error) {
 				Msg:   fmt.Sprintf("failed to write CNI configuration file %s: %v", dest, err),
 				State: models.StatusStateFailure,
 			})
+		} else if others := c.otherCNIConfigs(); len(others) > 0 {
+			c.status.Store(&models.Status{
+				Msg: fmt.Sprintf("wrote CNI configuration file to %s, but other CNI configurations are present: %s",
+					dest, strings.Join(others, ", ")),
+				State: models.StatusStateWarning,
+			})
 		} else {
 			c.status.Store(&models.Status{
 				Msg:   fmt.Sprintf("successfully wrote CNI configuration file to %s", dest),
@@ -444,6 +450,36 @@ func (c *cniConfigManager) cleanupOtherCNI() error {
 	return nil
 }
 
+// otherCNIConfigs returns the names of all active non-Cilium CNI
+// configuration files in the CNI configuration directory. The container
+// runtime picks the lexicographically first file, so any such file may
+// conflict with or take precedence over Cilium's own configuration. With
+// cni.exclusive enabled this list is normally empty, as conflicting files
+// are renamed away by cleanupOtherCNI.
+func (c *cniConfigManager) otherCNIConfigs() []string {
+	files, err := os.ReadDir(c.cniConfDir)
+	if err != nil {
+		c.log.Errorf("Failed to list CNI conf dir %s: %v", c.cniConfDir, err)
+		return nil
+	}
+
+	var others []string
+	for _, f := range files {
+		if f.IsDir() {
+			continue
+		}
+		name := f.Name()
+		if name == c.cniConfFile {
+			continue
+		}
+		if !(strings.HasSuffix(name, ".conf") || strings.HasSuffix(name, ".conflist") || strings.HasSuffix(name, ".json")) {
+			continue
+		}
+		others = append(others, name)
+	}
+	return others
+}
+
 // findCNINetwork scans a given directory for CNI configuration files,
 // returning the path to a file that contains a CNI **network** with the name
 // supplied.
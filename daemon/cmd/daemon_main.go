@@ -194,6 +194,9 @@ func InitGlobalFlags(cmd *cobra.Command, vp *viper.Viper) {
 	flags.String(option.AllowLocalhost, option.AllowLocalhostAuto, "Policy when to allow local stack to reach local endpoints { auto | always | policy }")
 	option.BindEnv(vp, option.AllowLocalhost)
 
+	flags.StringSlice(option.AllowLocalhostPorts, nil, "Restrict the implicit allow from the local stack to these \"port[/protocol]\" pairs (e.g. kubelet probe ports)")
+	option.BindEnv(vp, option.AllowLocalhostPorts)
+
 	flags.Bool(option.AnnotateK8sNode, defaults.AnnotateK8sNode, "Annotate Kubernetes node")
 	option.BindEnv(vp, option.AnnotateK8sNode)
 
@@ -1023,6 +1026,12 @@ func InitGlobalFlags(cmd *cobra.Command, vp *viper.Viper) {
 	flags.StringSlice(option.NodeLabels, []string{}, "List of label prefixes used to determine identity of a node (used only when enable-node-selector-labels is enabled)")
 	option.BindEnv(vp, option.NodeLabels)
 
+	flags.Bool(option.EnablePodSecurityLabels, false, "Enable derivation of well-known security labels (e.g. host network usage) from the pod specification into the endpoint labels")
+	option.BindEnv(vp, option.EnablePodSecurityLabels)
+
+	flags.Bool(option.EnablePodTopologyLabels, false, "Enable derivation of the node's topology labels (zone and region) into the endpoint labels, so that policies can select peers by zone or region")
+	option.BindEnv(vp, option.EnablePodTopologyLabels)
+
 	flags.Bool(option.EnableInternalTrafficPolicy, defaults.EnableInternalTrafficPolicy, "Enable internal traffic policy")
 	option.BindEnv(vp, option.EnableInternalTrafficPolicy)
 
@@ -1217,6 +1226,9 @@ func initEnv(vp *viper.Viper) {
 		log.Fatalf("Invalid setting for --allow-localhost, must be { %s, %s, %s }",
 			option.AllowLocalhostAuto, option.AllowLocalhostAlways, option.AllowLocalhostPolicy)
 	}
+	if err := policy.ValidateAllowLocalhostPorts(option.Config.AllowLocalhostPorts); err != nil {
+		log.WithError(err).Fatalf("Invalid setting for --%s", option.AllowLocalhostPorts)
+	}
 
 	scopedLog = log.WithField(logfields.Path, option.Config.SocketPath)
 	socketDir := path.Dir(option.Config.SocketPath)
@@ -1765,6 +1777,7 @@ func startDaemon(d *Daemon, restoredEndpoints *endpointRestoreState, cleaner *da
 			EndpointManager: d.endpointManager,
 		}, d.bwManager)
 		ms.CollectStaleMapGarbage()
+		ms.RemoveObsoleteMaps()
 		ms.RemoveDisabledMaps()
 
 		// Sleep for the --identity-restore-grace-period (default: 30 seconds k8s, 10 minutes kvstore), allowing
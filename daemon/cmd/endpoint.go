@@ -39,6 +39,7 @@ import (
 	"github.com/cilium/cilium/pkg/logging"
 	"github.com/cilium/cilium/pkg/logging/logfields"
 	"github.com/cilium/cilium/pkg/mac"
+	"github.com/cilium/cilium/pkg/metrics"
 	"github.com/cilium/cilium/pkg/option"
 	"github.com/cilium/cilium/pkg/resiliency"
 	"github.com/cilium/cilium/pkg/time"
@@ -208,7 +209,14 @@ func (d *Daemon) fetchK8sMetadataForEndpointFromPod(p *slim_corev1.Pod) (*endpoi
 		return nil, err
 	}
 
-	containerPorts, lbls := k8s.GetPodMetadata(logging.DefaultSlogLogger, ns, p)
+	var nodeLabels map[string]string
+	if option.Config.EnablePodTopologyLabels {
+		if localNode, err := d.nodeLocalStore.Get(d.ctx); err == nil {
+			nodeLabels = localNode.Labels
+		}
+	}
+
+	containerPorts, lbls := k8s.GetPodMetadata(logging.DefaultSlogLogger, ns, p, nodeLabels)
 	k8sLbls := labels.Map2Labels(lbls, labels.LabelSourceK8s)
 	identityLabels, infoLabels := labelsfilter.Filter(k8sLbls)
 	return &endpoint.K8sMetadata{
@@ -662,11 +670,14 @@ func putEndpointIDHandler(d *Daemon, params PutEndpointIDParams) (resp middlewar
 	}
 	defer r.Done()
 
+	started := time.Now()
 	ep, code, err := d.createEndpoint(params.HTTPRequest.Context(), epTemplate)
 	if err != nil {
+		metrics.EndpointCreationTimeStats.WithLabelValues(metrics.LabelValueOutcomeFail).Observe(time.Since(started).Seconds())
 		r.Error(err, code)
 		return api.Error(code, err)
 	}
+	metrics.EndpointCreationTimeStats.WithLabelValues(metrics.LabelValueOutcomeSuccess).Observe(time.Since(started).Seconds())
 
 	ep.Logger(daemonSubsys).Info("Successful endpoint creation")
 
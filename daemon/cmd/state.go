@@ -399,21 +399,28 @@ func (d *Daemon) allocateIPsLocked(ep *endpoint.Endpoint) (err error) {
 	if option.Config.EnableIPv6 && ep.IPv6.IsValid() {
 		ipv6Pool := ipam.PoolOrDefault(ep.IPv6IPAMPool)
 		_, err = d.ipam.AllocateIPWithoutSyncUpstream(ep.IPv6.AsSlice(), ep.HumanString()+" [restored]", ipv6Pool)
-		if err != nil {
+		switch {
+		case err != nil && errors.Is(err, &ipam.ErrIPNotInPoolRange{}):
+			logUnmanagedRestoredIP(ep, ep.IPv6.AsSlice(), err)
+			err = nil
+		case err != nil:
 			return fmt.Errorf("unable to reallocate %s IPv6 address: %w", ep.IPv6, err)
+		default:
+			defer func() {
+				if err != nil {
+					d.ipam.ReleaseIP(ep.IPv6.AsSlice(), ipv6Pool)
+				}
+			}()
 		}
-
-		defer func() {
-			if err != nil {
-				d.ipam.ReleaseIP(ep.IPv6.AsSlice(), ipv6Pool)
-			}
-		}()
 	}
 
 	if option.Config.EnableIPv4 && ep.IPv4.IsValid() {
 		ipv4Pool := ipam.PoolOrDefault(ep.IPv4IPAMPool)
 		_, err = d.ipam.AllocateIPWithoutSyncUpstream(ep.IPv4.AsSlice(), ep.HumanString()+" [restored]", ipv4Pool)
 		switch {
+		case err != nil && errors.Is(err, &ipam.ErrIPNotInPoolRange{}):
+			logUnmanagedRestoredIP(ep, ep.IPv4.AsSlice(), err)
+			err = nil
 		// We only check for BypassIPAllocUponRestore for IPv4 because we
 		// assume that this flag is only turned on for IPv4-only IPAM modes
 		// such as ENI.
@@ -442,6 +449,25 @@ func (d *Daemon) allocateIPsLocked(ep *endpoint.Endpoint) (err error) {
 	return nil
 }
 
+// logUnmanagedRestoredIP logs that a restored endpoint keeps an IP which is
+// not managed by the current IPAM configuration. This happens when the agent
+// was migrated to multi-pool IPAM (e.g. from cluster-pool) while endpoints
+// allocated by the previous mode are still running. The endpoint keeps its IP
+// until the pod is restarted, while new endpoints allocate from the multi-pool
+// pod CIDRs.
+func logUnmanagedRestoredIP(ep *endpoint.Endpoint, ip net.IP, err error) {
+	log.WithError(err).WithFields(logrus.Fields{
+		logfields.IPAddr:     ip,
+		logfields.EndpointID: ep.ID,
+		logfields.CEPName:    ep.GetK8sNamespaceAndCEPName(),
+	}).Warn(
+		"Restored endpoint IP is outside of all pod CIDRs of its IPAM pool. " +
+			"The IP was likely allocated by a different IPAM mode before " +
+			"migrating to multi-pool. The endpoint keeps the IP until the pod " +
+			"is restarted, but the IP is not tracked by IPAM.",
+	)
+}
+
 func (d *Daemon) initRestore(restoredEndpoints *endpointRestoreState, endpointsRegenerator *endpoint.Regenerator) {
 	bootstrapStats.restore.Start()
 	if option.Config.RestoreState {
@@ -51,6 +51,7 @@ var configModificationCell = cell.Module(
 
 	cell.Provide(newConfigModifyApiHandler),
 	cell.Provide(newConfigModifyEventHandler),
+	cell.Invoke(registerDynamicConfigWatcher),
 )
 
 type configModifyApiHandlerParams struct {
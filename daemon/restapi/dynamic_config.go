@@ -0,0 +1,115 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package restapi
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cilium/hive/cell"
+	"github.com/cilium/hive/job"
+	"github.com/cilium/statedb"
+	"github.com/sirupsen/logrus"
+
+	"github.com/cilium/cilium/api/v1/models"
+	daemonapi "github.com/cilium/cilium/api/v1/server/restapi/daemon"
+	"github.com/cilium/cilium/pkg/dynamicconfig"
+	"github.com/cilium/cilium/pkg/eventqueue"
+	"github.com/cilium/cilium/pkg/option"
+	"github.com/cilium/cilium/pkg/time"
+)
+
+// dynamicOptionKeys maps agent flag names, as they appear in the dynamic
+// configuration sources (the Cilium ConfigMap or CiliumNodeConfig objects),
+// to the runtime mutable daemon options they control.
+var dynamicOptionKeys = map[string]string{
+	option.DebugArg:               option.Debug,
+	option.MonitorAggregationName: option.MonitorAggregation,
+	option.PolicyAuditModeArg:     option.PolicyAuditMode,
+}
+
+type dynamicConfigWatcherParams struct {
+	cell.In
+
+	Logger   logrus.FieldLogger
+	JobGroup job.Group
+
+	DB                 *statedb.DB
+	DynamicConfigTable statedb.Table[dynamicconfig.DynamicConfig]
+	DynamicConfig      dynamicconfig.Config
+
+	EventHandler *ConfigModifyEventHandler
+}
+
+// registerDynamicConfigWatcher applies runtime mutable daemon options from
+// the dynamic configuration sources at runtime. Option changes take the same
+// path as the PATCH /config API, including validation, endpoint option
+// propagation and datapath reinitialization, so options such as debug,
+// monitor-aggregation and policy-audit-mode can be changed without restarting
+// the agent. Removing a key from all sources leaves the last applied value in
+// place until the agent is restarted.
+func registerDynamicConfigWatcher(p dynamicConfigWatcherParams) {
+	if !p.DynamicConfig.EnableDynamicConfig {
+		return
+	}
+
+	p.JobGroup.Add(job.OneShot("dynamic-option-watcher", func(ctx context.Context, health cell.Health) error {
+		var retryChan <-chan time.Time
+		for {
+			options := models.ConfigurationMap{}
+			keys, w := dynamicconfig.WatchAllKeys(p.DB.ReadTxn(), p.DynamicConfigTable)
+			for flagName, optKey := range dynamicOptionKeys {
+				if entry, ok := keys[flagName]; ok {
+					options[optKey] = entry.Value
+				}
+			}
+
+			retryChan = nil
+			if len(options) > 0 {
+				if err := p.EventHandler.applyDynamicOptions(options); err != nil {
+					p.Logger.WithError(err).Warn("Failed to apply dynamic configuration options, retrying later")
+					health.Degraded("Failed to apply dynamic configuration options", err)
+					retryChan = time.After(time.Second)
+				} else {
+					health.OK("OK")
+				}
+			}
+
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-w:
+			case <-retryChan:
+			}
+		}
+	}))
+}
+
+// applyDynamicOptions queues a configuration modification for the given
+// runtime options and waits for it to be applied.
+func (h *ConfigModifyEventHandler) applyDynamicOptions(options models.ConfigurationMap) error {
+	if h.configModifyQueue == nil {
+		return fmt.Errorf("configuration modification queue not initialized yet")
+	}
+
+	c := &ConfigModifyEvent{
+		params: daemonapi.PatchConfigParams{
+			Configuration: &models.DaemonConfigurationSpec{Options: options},
+		},
+		eventHandler: h,
+	}
+	resChan, err := h.configModifyQueue.Enqueue(eventqueue.NewEvent(c))
+	if err != nil {
+		return fmt.Errorf("enqueue of ConfigModifyEvent failed: %w", err)
+	}
+
+	res, ok := <-resChan
+	if !ok {
+		return fmt.Errorf("config modify event was cancelled")
+	}
+	if _, ok := res.(*daemonapi.PatchConfigOK); !ok {
+		return fmt.Errorf("failed to apply configuration options %v", options)
+	}
+	return nil
+}
@@ -49,6 +49,9 @@ func InitGlobalFlags(cmd *cobra.Command, vp *viper.Viper) {
 	flags.Int64(operatorOption.ParallelAllocWorkers, defaults.ParallelAllocWorkers, "Maximum number of parallel IPAM workers")
 	option.BindEnv(vp, operatorOption.ParallelAllocWorkers)
 
+	flags.Int(operatorOption.PendingPodSurgeLimit, 0, "Maximum number of IPs allocated beyond the pre-allocation watermark to serve pending pods on a node (0 to disable the limit)")
+	option.BindEnv(vp, operatorOption.PendingPodSurgeLimit)
+
 	// Operator-specific flags
 	flags.String(option.ConfigFile, "", `Configuration file (default "$HOME/ciliumd.yaml")`)
 	option.BindEnv(vp, option.ConfigFile)
@@ -262,6 +265,9 @@ func InitGlobalFlags(cmd *cobra.Command, vp *viper.Viper) {
 	flags.Bool(operatorOption.SetCiliumIsUpCondition, true, "Set CiliumIsUp Node condition to mark a Kubernetes Node that a Cilium pod is up and running in that node")
 	option.BindEnv(vp, operatorOption.SetCiliumIsUpCondition)
 
+	flags.StringSlice(operatorOption.NodeTaintReadinessConditions, nil, fmt.Sprintf("List of additional Kubernetes node condition types that must report status True before the node taint %q is removed, so that scheduling can be gated on readiness signals set by other controllers", option.Config.AgentNotReadyNodeTaintValue()))
+	option.BindEnv(vp, operatorOption.NodeTaintReadinessConditions)
+
 	flags.String(operatorOption.PodRestartSelector, "k8s-app=kube-dns", "cilium-operator will delete/restart any pods with these labels if the pod is not managed by Cilium. If this option is empty, then all pods may be restarted")
 	option.BindEnv(vp, operatorOption.PodRestartSelector)
 
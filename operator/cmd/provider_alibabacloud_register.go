@@ -6,10 +6,11 @@
 package cmd
 
 import (
+	"github.com/cilium/cilium/pkg/ipam/allocator"
 	"github.com/cilium/cilium/pkg/ipam/allocator/alibabacloud"
 	ipamOption "github.com/cilium/cilium/pkg/ipam/option"
 )
 
 func init() {
-	allocatorProviders[ipamOption.IPAMAlibabaCloud] = &alibabacloud.AllocatorAlibabaCloud{}
+	allocator.Register(ipamOption.IPAMAlibabaCloud, &alibabacloud.AllocatorAlibabaCloud{})
 }
@@ -7,11 +7,12 @@ package cmd
 
 import (
 	// These dependencies should be included only when this file is included in the build.
+	"github.com/cilium/cilium/pkg/ipam/allocator"
 	allocatorAWS "github.com/cilium/cilium/pkg/ipam/allocator/aws" // AWS allocator.
 	ipamOption "github.com/cilium/cilium/pkg/ipam/option"
 	_ "github.com/cilium/cilium/pkg/policy/groups/aws" // Register AWS policy group provider.
 )
 
 func init() {
-	allocatorProviders[ipamOption.IPAMENI] = &allocatorAWS.AllocatorAWS{}
+	allocator.Register(ipamOption.IPAMENI, &allocatorAWS.AllocatorAWS{})
 }
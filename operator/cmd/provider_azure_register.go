@@ -7,10 +7,11 @@ package cmd
 
 import (
 	// These dependencies should be included only when this file is included in the build.
+	"github.com/cilium/cilium/pkg/ipam/allocator"
 	allocatorAzure "github.com/cilium/cilium/pkg/ipam/allocator/azure" // Azure allocator task.
 	ipamOption "github.com/cilium/cilium/pkg/ipam/option"
 )
 
 func init() {
-	allocatorProviders[ipamOption.IPAMAzure] = &allocatorAzure.AllocatorAzure{}
+	allocator.Register(ipamOption.IPAMAzure, &allocatorAzure.AllocatorAzure{})
 }
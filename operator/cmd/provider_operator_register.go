@@ -7,12 +7,13 @@ package cmd
 
 import (
 	// These dependencies should be included only when this file is included in the build.
+	"github.com/cilium/cilium/pkg/ipam/allocator"
 	"github.com/cilium/cilium/pkg/ipam/allocator/clusterpool"
 	"github.com/cilium/cilium/pkg/ipam/allocator/multipool"
 	ipamOption "github.com/cilium/cilium/pkg/ipam/option"
 )
 
 func init() {
-	allocatorProviders[ipamOption.IPAMClusterPool] = &clusterpool.AllocatorOperator{}
-	allocatorProviders[ipamOption.IPAMMultiPool] = &multipool.Allocator{}
+	allocator.Register(ipamOption.IPAMClusterPool, &clusterpool.AllocatorOperator{})
+	allocator.Register(ipamOption.IPAMMultiPool, &multipool.Allocator{})
 }
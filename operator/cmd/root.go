@@ -575,17 +575,21 @@ func (legacy *legacyOnLeader) onStart(_ cell.HookContext) error {
 	log.WithField(logfields.Mode, option.Config.IPAM).Info("Initializing IPAM")
 	watcherLogger := legacy.logger.With(logfields.LogSubsys, "watchers")
 
-	switch ipamMode := option.Config.IPAM; ipamMode {
+	ipamMode := option.Config.IPAM
+	alloc, providerRegistered := allocator.GetProvider(ipamMode)
+
+	switch ipamMode {
 	case ipamOption.IPAMAzure,
 		ipamOption.IPAMENI,
 		ipamOption.IPAMClusterPool,
 		ipamOption.IPAMMultiPool,
 		ipamOption.IPAMAlibabaCloud:
-		alloc, providerBuiltin := allocatorProviders[ipamMode]
-		if !providerBuiltin {
+		if !providerRegistered {
 			log.Fatalf("%s allocator is not supported by this version of %s", ipamMode, binaryName)
 		}
+	}
 
+	if providerRegistered {
 		if err := alloc.Init(legacy.ctx, logging.DefaultSlogLogger); err != nil {
 			log.WithError(err).Fatalf("Unable to init %s allocator", ipamMode)
 		}
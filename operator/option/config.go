@@ -124,6 +124,11 @@ const (
 	// ParallelAllocWorkers specifies the number of parallel workers to be used for IPAM allocation
 	ParallelAllocWorkers = "parallel-alloc-workers"
 
+	// PendingPodSurgeLimit caps the number of additional IPs allocated on top
+	// of the pre-allocation watermark to serve pending pods on a node.
+	// 0 disables the limit.
+	PendingPodSurgeLimit = "pending-pod-surge-limit"
+
 	// EC2APIEndpoint is the custom API endpoint to use for the EC2 AWS service,
 	// e.g. "ec2-fips.us-west-1.amazonaws.com" to use a FIPS endpoint in the us-west-1 region.
 	EC2APIEndpoint = "ec2-api-endpoint"
@@ -211,6 +216,11 @@ const (
 	// nodes.
 	SetCiliumIsUpCondition = "set-cilium-is-up-condition"
 
+	// NodeTaintReadinessConditions is a list of additional node condition
+	// types that must report status True before the Cilium node taint is
+	// removed from a node.
+	NodeTaintReadinessConditions = "node-taint-readiness-conditions"
+
 	// PodRestartSelector specify the labels contained in the pod that needs to be restarted before the node can be de-stained
 	// default values: k8s-app=kube-dns
 	PodRestartSelector = "pod-restart-selector"
@@ -312,6 +322,11 @@ type OperatorConfig struct {
 	// ParallelAllocWorkers specifies the number of parallel workers to be used for accessing cloud provider APIs .
 	ParallelAllocWorkers int64
 
+	// PendingPodSurgeLimit caps the number of additional IPs allocated on top
+	// of the pre-allocation watermark to serve pending pods on a node.
+	// 0 disables the limit.
+	PendingPodSurgeLimit int
+
 	// AWSReleaseExcessIps allows releasing excess free IP addresses from ENI.
 	// Enabling this option reduces waste of IP addresses but may increase
 	// the number of API calls to AWS EC2 service.
@@ -391,6 +406,11 @@ type OperatorConfig struct {
 	// nodes.
 	SetCiliumIsUpCondition bool
 
+	// NodeTaintReadinessConditions is a list of additional node condition
+	// types that must report status True before the Cilium node taint is
+	// removed from a node.
+	NodeTaintReadinessConditions []string
+
 	// PodRestartSelector specify the labels contained in the pod that needs to be restarted before the node can be de-stained
 	PodRestartSelector string
 }
@@ -424,6 +444,7 @@ func (c *OperatorConfig) Populate(vp *viper.Viper) {
 	c.RemoveCiliumNodeTaints = vp.GetBool(RemoveCiliumNodeTaints)
 	c.SetCiliumNodeTaints = vp.GetBool(SetCiliumNodeTaints)
 	c.SetCiliumIsUpCondition = vp.GetBool(SetCiliumIsUpCondition)
+	c.NodeTaintReadinessConditions = vp.GetStringSlice(NodeTaintReadinessConditions)
 	c.PodRestartSelector = vp.GetString(PodRestartSelector)
 
 	c.CiliumK8sNamespace = vp.GetString(CiliumK8sNamespace)
@@ -441,6 +462,7 @@ func (c *OperatorConfig) Populate(vp *viper.Viper) {
 	c.IPAMAPIQPSLimit = vp.GetFloat64(IPAMAPIQPSLimit)
 	c.IPAMAPIBurst = vp.GetInt(IPAMAPIBurst)
 	c.ParallelAllocWorkers = vp.GetInt64(ParallelAllocWorkers)
+	c.PendingPodSurgeLimit = vp.GetInt(PendingPodSurgeLimit)
 
 	// Gateways and Ingress
 	c.KubeProxyReplacement = vp.GetString(KubeProxyReplacement)
@@ -13,6 +13,7 @@ import (
 	envoy_config_listener "github.com/cilium/proxy/go/envoy/config/listener/v3"
 	envoy_config_route_v3 "github.com/cilium/proxy/go/envoy/config/route/v3"
 	envoy_http_connection_manager_v3 "github.com/cilium/proxy/go/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoy_extensions_filters_network_tcp_v3 "github.com/cilium/proxy/go/envoy/extensions/filters/network/tcp_proxy/v3"
 	envoy_transport_sockets_tls_v3 "github.com/cilium/proxy/go/envoy/extensions/transport_sockets/tls/v3"
 	matcherv3 "github.com/cilium/proxy/go/envoy/type/matcher/v3"
 	"github.com/google/go-cmp/cmp"
@@ -353,6 +354,80 @@ func TestSharedIngressTranslator_getListener(t *testing.T) {
 	require.Nil(t, downStreamTLS.CommonTlsContext.TlsCertificateSdsSecretConfigs[0].GetSdsConfig())
 }
 
+func TestSharedIngressTranslator_getListenerMixedTerminationAndPassthrough(t *testing.T) {
+	i := &cecTranslator{
+		Config: Config{
+			SecretsNamespace: "cilium-secrets",
+		},
+	}
+
+	// Terminated HTTPS and TLS passthrough side-by-side on the same shared
+	// listener, disambiguated via SNI.
+	res, err := i.desiredEnvoyListener(&model.Model{
+		HTTP: []model.HTTPListener{
+			{
+				Port:     443,
+				Hostname: "terminated.example.com",
+				TLS: []model.TLSSecret{
+					{
+						Name:      "dummy-secret",
+						Namespace: "dummy-namespace",
+					},
+				},
+			},
+		},
+		TLSPassthrough: []model.TLSPassthroughListener{
+			{
+				Port:     443,
+				Hostname: "passthrough.example.com",
+				Routes: []model.TLSPassthroughRoute{
+					{
+						Hostnames: []string{"passthrough.example.com"},
+						Backends: []model.Backend{
+							{
+								Name:      "tls-service",
+								Namespace: "default",
+								Port: &model.BackendPort{
+									Port: 443,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, res, 1)
+
+	listener := &envoy_config_listener.Listener{}
+	err = proto.Unmarshal(res[0].GetValue(), listener)
+	require.NoError(t, err)
+
+	// insecure HTTP chain, terminated HTTPS chain and passthrough chain
+	require.Len(t, listener.FilterChains, 3)
+
+	// terminated HTTPS chain matches its SNI and carries a TLS transport socket
+	secureChain := listener.FilterChains[1]
+	require.Equal(t, []string{"terminated.example.com"}, secureChain.FilterChainMatch.ServerNames)
+	require.Equal(t, "tls", secureChain.FilterChainMatch.TransportProtocol)
+	require.Equal(t, "envoy.transport_sockets.tls", secureChain.TransportSocket.Name)
+	require.Equal(t, httpConnectionManagerType, secureChain.Filters[0].Name)
+
+	// passthrough chain matches its SNI and forwards raw TLS via TCP proxy,
+	// without terminating TLS
+	passthroughChain := listener.FilterChains[2]
+	require.Equal(t, []string{"passthrough.example.com"}, passthroughChain.FilterChainMatch.ServerNames)
+	require.Equal(t, "tls", passthroughChain.FilterChainMatch.TransportProtocol)
+	require.Nil(t, passthroughChain.TransportSocket)
+	require.Equal(t, tcpProxyType, passthroughChain.Filters[0].Name)
+
+	tcpProxy := &envoy_extensions_filters_network_tcp_v3.TcpProxy{}
+	err = proto.Unmarshal(passthroughChain.Filters[0].ConfigType.(*envoy_config_listener.Filter_TypedConfig).TypedConfig.Value, tcpProxy)
+	require.NoError(t, err)
+	require.Equal(t, "default:tls-service:443", tcpProxy.GetCluster())
+}
+
 func TestSharedIngressTranslator_getClusters(t *testing.T) {
 	type args struct {
 		m *model.Model
@@ -116,6 +116,16 @@ func checkAndMarkNode(ctx context.Context, c kubernetes.Interface, nodeGetter sl
 	// should we remove the taint?
 	scheduled, running := nodeHasCiliumPod(node.GetName())
 	if running {
+		// Do not remove the taint as long as one of the configured readiness
+		// conditions is not satisfied on the node. The node update caused by
+		// the condition transition re-triggers the check.
+		if !nodeReadinessConditionsMet(node, options.ReadinessConditions) {
+			logger.Info(
+				"Cilium pod running for node, but not all readiness conditions are met; leaving taint in place",
+				logfields.NodeName, node.GetName(),
+			)
+			options.RemoveNodeTaint = false
+		}
 		if (options.RemoveNodeTaint && hasAgentNotReadyTaint(node)) ||
 			(options.SetCiliumIsUpCondition && !HasCiliumIsUpCondition(node)) {
 			logger.Info("Cilium pod running for node; marking accordingly", logfields.NodeName, node.GetName())
@@ -195,6 +205,24 @@ func nodeHasCiliumPod(nodeName string) (scheduled bool, ready bool) {
 	return true, false
 }
 
+// nodeReadinessConditionsMet returns true if all the given node condition
+// types report status True on the node.
+func nodeReadinessConditionsMet(k8sNode *slim_corev1.Node, conditionTypes []string) bool {
+	for _, conditionType := range conditionTypes {
+		met := false
+		for _, condition := range k8sNode.Status.Conditions {
+			if condition.Type == slim_corev1.NodeConditionType(conditionType) {
+				met = condition.Status == slim_corev1.ConditionTrue
+				break
+			}
+		}
+		if !met {
+			return false
+		}
+	}
+	return true
+}
+
 // hasAgentNotReadyTaint returns true if the given node has the Cilium Agent
 // Not Ready Node Taint.
 func hasAgentNotReadyTaint(k8sNode *slim_corev1.Node) bool {
@@ -444,6 +472,7 @@ type markNodeOptions struct {
 	RemoveNodeTaint        bool
 	SetNodeTaint           bool
 	SetCiliumIsUpCondition bool
+	ReadinessConditions    []string
 }
 
 // markNode marks the Kubernetes node depending on the modes that it is passed
@@ -477,6 +506,7 @@ func HandleNodeTolerationAndTaints(wg *sync.WaitGroup, clientset k8sClient.Clien
 		RemoveNodeTaint:        option.Config.RemoveCiliumNodeTaints,
 		SetNodeTaint:           option.Config.SetCiliumNodeTaints,
 		SetCiliumIsUpCondition: option.Config.SetCiliumIsUpCondition,
+		ReadinessConditions:    option.Config.NodeTaintReadinessConditions,
 	}
 
 	nodesInit(wg, clientset.Slim(), stopCh, logger)
@@ -155,6 +155,111 @@ func TestNodeTaintWithoutCondition(t *testing.T) {
 	require.NoError(t, err, "Patch was never received by k8s fake client")
 }
 
+func TestNodeTaintWithReadinessConditions(t *testing.T) {
+	logger := hivetest.Logger(t)
+
+	mno = markNodeOptions{
+		RemoveNodeTaint:        true,
+		SetNodeTaint:           true,
+		SetCiliumIsUpCondition: false,
+		ReadinessConditions:    []string{"cilium.io/BGPSessionsEstablished"},
+	}
+
+	// create node1 with taint, and without the configured readiness condition
+	node1WithTaint := &slim_corev1.Node{
+		ObjectMeta: slim_metav1.ObjectMeta{
+			Name: "k8s1",
+		},
+		Spec: slim_corev1.NodeSpec{
+			Taints: []slim_corev1.Taint{
+				{
+					Key: pkgOption.Config.AgentNotReadyNodeTaintValue(), Value: "Foo",
+				},
+			},
+		},
+		Status: slim_corev1.NodeStatus{
+			Conditions: nil,
+		},
+	}
+
+	ciliumPodOnNode1 := &slim_corev1.Pod{
+		Spec: slim_corev1.PodSpec{
+			NodeName: "k8s1",
+		},
+		Status: slim_corev1.PodStatus{
+			Conditions: []slim_corev1.PodCondition{
+				{
+					Type:   slim_corev1.PodReady,
+					Status: slim_corev1.ConditionTrue,
+				},
+			},
+		},
+	}
+
+	// Add the cilium pod that is running on k8s1
+	err := ciliumPodsStore.Add(ciliumPodOnNode1)
+	require.NoError(t, err)
+
+	patchReceived := make(chan struct{}, 1)
+
+	// Create a fake client to receive the patch from cilium-operator
+	fakeClient := &fake.Clientset{}
+	fakeClient.AddReactor("patch", "nodes", func(action k8sTesting.Action) (handled bool, ret runtime.Object, err error) {
+		patchReceived <- struct{}{}
+		return true, nil, nil
+	})
+
+	fng := &fakeNodeGetter{
+		OnGetK8sSlimNode: func(nodeName string) (*slim_corev1.Node, error) {
+			require.Equal(t, "k8s1", nodeName)
+			return node1WithTaint, nil
+		},
+	}
+
+	nodeQueue := workqueue.NewTypedRateLimitingQueue[string](workqueue.DefaultTypedControllerRateLimiter[string]())
+
+	key, err := queueKeyFunc(node1WithTaint)
+	require.NoError(t, err)
+
+	// The readiness condition is not set on the node, hence the taint must
+	// not be removed even though the Cilium pod is running.
+	nodeQueue.Add(key)
+	continueProcess := checkTaintForNextNodeItem(fakeClient, fng, nodeQueue, logger)
+	require.True(t, continueProcess)
+
+	err = testutils.WaitUntil(func() bool {
+		select {
+		case <-patchReceived:
+			return true
+		default:
+			return false
+		}
+	}, 1*time.Second)
+	require.Error(t, err, "Taint patch was sent although the readiness condition is not met")
+
+	// Once the readiness condition transitions to True, the taint is removed.
+	node1WithTaint.Status.Conditions = []slim_corev1.NodeCondition{
+		{
+			Type:   "cilium.io/BGPSessionsEstablished",
+			Status: slim_corev1.ConditionTrue,
+		},
+	}
+
+	nodeQueue.Add(key)
+	continueProcess = checkTaintForNextNodeItem(fakeClient, fng, nodeQueue, logger)
+	require.True(t, continueProcess)
+
+	err = testutils.WaitUntil(func() bool {
+		select {
+		case <-patchReceived:
+			return true
+		default:
+			return false
+		}
+	}, 1*time.Second)
+	require.NoError(t, err, "Patch was never received by k8s fake client")
+}
+
 func TestNodeCondition(t *testing.T) {
 	logger := hivetest.Logger(t)
 	mno = markNodeOptions{
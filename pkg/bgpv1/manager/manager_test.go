@@ -39,6 +39,7 @@ var (
 	testInvalidNeighborIP = "1.2.3.4"
 
 	tableTypeLocRib       = "loc-rib"
+	tableTypeLocAdjRibIn  = "adj-rib-in"
 	tableTypeLocAdjRibOut = "adj-rib-out"
 	afiIPv4               = "ipv4"
 	afiIPv6               = "ipv6"
@@ -155,6 +156,28 @@ func TestGetRoutes(t *testing.T) {
 			neighbor:           ptr.To[string](testInvalidNeighborIP),
 			expectedErr:        fmt.Errorf(""),
 		},
+		{
+			name:               "unspecified neighbor for adj-rib-in",
+			advertisedPrefixes: testSingleIPv4Prefix,
+			expectedPrefixes:   nil, // nil as the neighbor never goes UP
+			routerASN:          nil,
+			tableType:          tableTypeLocAdjRibIn,
+			afi:                afiIPv4,
+			safi:               safiUnicast,
+			neighbor:           nil,
+			expectedErr:        nil,
+		},
+		{
+			name:               "valid neighbor for adj-rib-in",
+			advertisedPrefixes: testSingleIPv4Prefix,
+			expectedPrefixes:   nil, // nil as the neighbor never goes UP
+			routerASN:          nil,
+			tableType:          tableTypeLocAdjRibIn,
+			afi:                afiIPv4,
+			safi:               safiUnicast,
+			neighbor:           ptr.To[string](testNeighborIP),
+			expectedErr:        nil,
+		},
 	}
 
 	for _, tt := range table {
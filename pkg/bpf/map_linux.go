@@ -111,6 +111,14 @@ type Map struct {
 	// pressureGauge is a metric that tracks the pressure on this map
 	pressureGauge *metrics.GaugeWithThreshold
 
+	// peakPressureGauge is a metric that tracks the peak pressure observed
+	// on this map since the agent started. It can be used to right-size the
+	// map without having to continuously watch the pressure metric.
+	peakPressureGauge *metrics.GaugeWithThreshold
+
+	// peakPressure is the highest pressure value reported so far.
+	peakPressure float64
+
 	// is true when events buffer is enabled.
 	eventsBufferEnabled bool
 
@@ -321,6 +329,7 @@ func (m *Map) WithPressureMetricThreshold(threshold float64) *Map {
 	}
 
 	m.pressureGauge = metrics.NewBPFMapPressureGauge(m.NonPrefixedName(), threshold)
+	m.peakPressureGauge = metrics.NewBPFMapPeakPressureGauge(m.NonPrefixedName(), threshold)
 
 	return m
 }
@@ -344,11 +353,16 @@ func (m *Map) UpdatePressureMetricWithSize(size int32) {
 			m.cache = nil
 		}
 		m.pressureGauge = nil
+		m.peakPressureGauge = nil
 		return
 	}
 
 	pvalue := float64(size) / float64(m.MaxEntries())
 	m.pressureGauge.Set(pvalue)
+	if pvalue > m.peakPressure {
+		m.peakPressure = pvalue
+		m.peakPressureGauge.Set(pvalue)
+	}
 }
 
 func (m *Map) updatePressureMetric() {
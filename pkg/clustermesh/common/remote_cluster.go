@@ -333,6 +333,7 @@ func (rc *remoteCluster) getClusterConfig(ctx context.Context, backend kvstore.B
 		rc.config.Kvstoremesh = config.Capabilities.Cached
 		rc.config.SyncCanaries = config.Capabilities.SyncedCanaries
 		rc.config.ServiceExportsEnabled = config.Capabilities.ServiceExportsEnabled
+		rc.config.TunnelPort = int64(config.TunnelPort)
 		rc.mutex.Unlock()
 
 		return config, nil
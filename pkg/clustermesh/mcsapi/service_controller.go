@@ -9,6 +9,7 @@ import (
 	"encoding/base32"
 	"log/slog"
 	"maps"
+	"slices"
 	"strings"
 
 	corev1 "k8s.io/api/core/v1"
@@ -94,15 +95,31 @@ func servicePorts(svcImport *mcsapiv1alpha1.ServiceImport, localSvc *corev1.Serv
 	return ports
 }
 
-func (r *mcsAPIServiceReconciler) addServiceImportDerivedAnnotation(ctx context.Context, svcImport *mcsapiv1alpha1.ServiceImport, derivedServiceName string) error {
+// updateServiceImport reflects the derived Service back on the ServiceImport
+// object: the derived Service annotation and the ClusterIPs that
+// kube-apiserver allocated to the derived Service, so that consumers of the
+// MCS API can rely on spec.ips rather than on the derived Service name.
+func (r *mcsAPIServiceReconciler) updateServiceImport(ctx context.Context, svcImport *mcsapiv1alpha1.ServiceImport, svc *corev1.Service) error {
+	changed := false
 	if svcImport.Annotations == nil {
 		svcImport.Annotations = map[string]string{}
 	}
-	if svcImport.Annotations[mcsapicontrollers.DerivedServiceAnnotation] != derivedServiceName {
-		svcImport.Annotations[mcsapicontrollers.DerivedServiceAnnotation] = derivedServiceName
-		if err := r.Client.Update(ctx, svcImport); err != nil {
-			return err
-		}
+	if svcImport.Annotations[mcsapicontrollers.DerivedServiceAnnotation] != svc.Name {
+		svcImport.Annotations[mcsapicontrollers.DerivedServiceAnnotation] = svc.Name
+		changed = true
+	}
+
+	var ips []string
+	if svc.Spec.ClusterIP != corev1.ClusterIPNone {
+		ips = svc.Spec.ClusterIPs
+	}
+	if !slices.Equal(svcImport.Spec.IPs, ips) {
+		svcImport.Spec.IPs = ips
+		changed = true
+	}
+
+	if changed {
+		return r.Client.Update(ctx, svcImport)
 	}
 	return nil
 }
@@ -236,9 +253,10 @@ func (r *mcsAPIServiceReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 		}
 	}
 
-	// Update the derived Service annotation on the ServiceImport object
-	// only after that the derived Service has been created for higher consistency.
-	return controllerruntime.Fail(r.addServiceImportDerivedAnnotation(ctx, svcImport, derivedServiceName))
+	// Update the derived Service annotation and the allocated IPs on the
+	// ServiceImport object only after that the derived Service has been
+	// created for higher consistency.
+	return controllerruntime.Fail(r.updateServiceImport(ctx, svcImport, svc))
 }
 
 // SetupWithManager sets up the controller with the Manager.
@@ -142,6 +142,10 @@ var (
 				Name:      derivedName(types.NamespacedName{Name: "full-update", Namespace: "default"}),
 				Namespace: "default",
 			},
+			Spec: corev1.ServiceSpec{
+				ClusterIP:  "10.0.0.42",
+				ClusterIPs: []string{"10.0.0.42"},
+			},
 		},
 
 		&mcsapiv1alpha1.ServiceImport{
@@ -296,6 +300,11 @@ func Test_mcsDerivedService_Reconcile(t *testing.T) {
 			err = c.Get(context.Background(), key, svcImport)
 			require.NoError(t, err)
 			require.Equal(t, keyDerived.Name, svcImport.Annotations[mcsapicontrollers.DerivedServiceAnnotation])
+			if name == "full-update" {
+				// The ClusterIPs allocated to the pre-existing derived Service
+				// must be reflected on the ServiceImport object.
+				require.Equal(t, []string{"10.0.0.42"}, svcImport.Spec.IPs)
+			}
 		}
 	})
 
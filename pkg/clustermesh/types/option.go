@@ -22,6 +22,9 @@ const (
 
 	// OptMaxConnectedClusters is the name of the OptMaxConnectedClusters option
 	OptMaxConnectedClusters = "max-connected-clusters"
+
+	// OptClusterTunnelPort is the name of the OptClusterTunnelPort option
+	OptClusterTunnelPort = "cluster-tunnel-port"
 )
 
 // ClusterInfo groups together the ClusterID and the ClusterName
@@ -29,6 +32,7 @@ type ClusterInfo struct {
 	ID                   uint32 `mapstructure:"cluster-id"`
 	Name                 string `mapstructure:"cluster-name"`
 	MaxConnectedClusters uint32 `mapstructure:"max-connected-clusters"`
+	TunnelPort           uint16 `mapstructure:"cluster-tunnel-port"`
 }
 
 // DefaultClusterInfo represents the default ClusterInfo values.
@@ -36,6 +40,7 @@ var DefaultClusterInfo = ClusterInfo{
 	ID:                   0,
 	Name:                 defaults.ClusterName,
 	MaxConnectedClusters: defaults.MaxConnectedClusters,
+	TunnelPort:           0,
 }
 
 // Flags implements the cell.Flagger interface, to register the given flags.
@@ -43,6 +48,7 @@ func (def ClusterInfo) Flags(flags *pflag.FlagSet) {
 	flags.Uint32(OptClusterID, def.ID, "Unique identifier of the cluster")
 	flags.String(OptClusterName, def.Name, "Name of the cluster. It must consist of at most 32 lower case alphanumeric characters and '-', start and end with an alphanumeric character.")
 	flags.Uint32(OptMaxConnectedClusters, def.MaxConnectedClusters, "Maximum number of clusters to be connected in a clustermesh. Increasing this value will reduce the maximum number of identities available. Valid configurations are [255, 511].")
+	flags.Uint16(OptClusterTunnelPort, def.TunnelPort, "UDP port on which the tunnel endpoints of the cluster listen, advertised to remote clusters via the cluster configuration. 0 means the default port of the tunnel protocol.")
 }
 
 // Validate validates that the ClusterID is in the valid range (including ClusterID == 0),
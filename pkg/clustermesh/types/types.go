@@ -96,6 +96,13 @@ func RegisterClusterInfoValidator(lc cell.Lifecycle, cinfo ClusterInfo) {
 type CiliumClusterConfig struct {
 	ID uint32 `json:"id,omitempty"`
 
+	// TunnelPort is the UDP port on which the tunnel endpoints of the
+	// cluster listen, if different from the default port of the tunnel
+	// protocol. It allows clusters in a mesh to use heterogeneous tunnel
+	// ports, with peers learning the port from the cluster configuration
+	// rather than requiring a uniform setting.
+	TunnelPort uint16 `json:"tunnelPort,omitempty"`
+
 	Capabilities CiliumClusterConfigCapabilities `json:"capabilities,omitempty"`
 }
 
@@ -11,7 +11,10 @@ import (
 	"log/slog"
 	"net"
 	"net/netip"
+	"os"
+	"path/filepath"
 	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/cilium/ebpf"
@@ -442,6 +445,23 @@ func populateFromLink(d *tables.Device, link netlink.Link) {
 	d.RawFlags = a.RawFlags
 	d.MasterIndex = a.MasterIndex
 	d.Type = link.Type()
+	d.NUMANode = deviceNUMANode(a.Name)
+}
+
+// deviceNUMANode returns the NUMA node of the underlying (e.g. PCI) device.
+// Returns -1 if the node is unknown, the system has no NUMA affinity for the
+// device, or the device is virtual. This matches the kernel's convention of
+// reporting -1 in sysfs for devices without a NUMA node.
+func deviceNUMANode(name string) int {
+	raw, err := os.ReadFile(filepath.Join("/sys/class/net", name, "device", "numa_node"))
+	if err != nil {
+		return -1
+	}
+	node, err := strconv.Atoi(strings.TrimSpace(string(raw)))
+	if err != nil {
+		return -1
+	}
+	return node
 }
 
 // processBatch processes a batch of address, link and route updates.
@@ -95,7 +95,9 @@ type ProgramHelper struct {
 }
 
 type miscFeatures struct {
-	HaveFibIfindex bool
+	HaveFibIfindex      bool
+	HaveSKLookup        bool
+	HaveUDPSegmentation bool
 }
 
 type FeatureProbes struct {
@@ -414,6 +416,14 @@ func HaveWriteableQueueMapping() error {
 	return features.HaveProgramHelper(ebpf.SchedCLS, asm.FnSkbEcnSetCe)
 }
 
+// HaveSKLookup checks if the kernel supports BPF sk_lookup programs, added in
+// e9ddbb7707ff ("bpf: Introduce SK_LOOKUP program type with a dedicated attach
+// point") which is 5.9+. Socket steering via sk_lookup allows proxies to
+// receive redirected traffic without iptables TPROXY rules.
+func HaveSKLookup() error {
+	return features.HaveProgramType(ebpf.SkLookup)
+}
+
 // HaveV2ISA is a wrapper around features.HaveV2ISA() to check if the kernel
 // supports the V2 ISA.
 // On unexpected probe results this function will terminate with log.Fatal().
@@ -647,6 +657,23 @@ func HaveIPv6Support() error {
 	return nil
 }
 
+// HaveUDPSegmentation tests whether the kernel supports UDP GSO (UDP_SEGMENT,
+// 4.18+) by setting the socket option on a scratch UDP socket. With UDP GSO
+// the forwarding path can preserve large UDP segments end-to-end, which
+// significantly improves throughput for QUIC-heavy workloads.
+func HaveUDPSegmentation() error {
+	fd, err := unix.Socket(unix.AF_INET, unix.SOCK_DGRAM, 0)
+	if err != nil {
+		return err
+	}
+	defer unix.Close(fd)
+	err = unix.SetsockoptInt(fd, unix.IPPROTO_UDP, unix.UDP_SEGMENT, 1400)
+	if errors.Is(err, unix.ENOPROTOOPT) {
+		return ErrNotSupported
+	}
+	return err
+}
+
 // CreateHeaderFiles creates C header files with macros indicating which BPF
 // features are available in the kernel.
 func CreateHeaderFiles(headerDir string, probes *FeatureProbes) error {
@@ -719,6 +746,8 @@ func ExecuteHeaderProbes(logger *slog.Logger) *FeatureProbes {
 	}
 
 	probes.Misc.HaveFibIfindex = (HaveFibIfindex() == nil)
+	probes.Misc.HaveSKLookup = (HaveSKLookup() == nil)
+	probes.Misc.HaveUDPSegmentation = (HaveUDPSegmentation() == nil)
 
 	return &probes
 }
@@ -732,10 +761,12 @@ func writeCommonHeader(writer io.Writer, probes *FeatureProbes) error {
 			probes.ProgramHelpers[ProgramHelper{ebpf.CGroupSockAddr, asm.FnJiffies64}] &&
 			probes.ProgramHelpers[ProgramHelper{ebpf.SchedCLS, asm.FnJiffies64}] &&
 			probes.ProgramHelpers[ProgramHelper{ebpf.XDP, asm.FnJiffies64}],
-		"HAVE_CGROUP_ID":   probes.ProgramHelpers[ProgramHelper{ebpf.CGroupSockAddr, asm.FnGetCurrentCgroupId}],
-		"HAVE_SET_RETVAL":  probes.ProgramHelpers[ProgramHelper{ebpf.CGroupSock, asm.FnSetRetval}],
-		"HAVE_FIB_NEIGH":   probes.ProgramHelpers[ProgramHelper{ebpf.SchedCLS, asm.FnRedirectNeigh}],
-		"HAVE_FIB_IFINDEX": probes.Misc.HaveFibIfindex,
+		"HAVE_CGROUP_ID":        probes.ProgramHelpers[ProgramHelper{ebpf.CGroupSockAddr, asm.FnGetCurrentCgroupId}],
+		"HAVE_SET_RETVAL":       probes.ProgramHelpers[ProgramHelper{ebpf.CGroupSock, asm.FnSetRetval}],
+		"HAVE_FIB_NEIGH":        probes.ProgramHelpers[ProgramHelper{ebpf.SchedCLS, asm.FnRedirectNeigh}],
+		"HAVE_FIB_IFINDEX":      probes.Misc.HaveFibIfindex,
+		"HAVE_SK_LOOKUP":        probes.Misc.HaveSKLookup,
+		"HAVE_UDP_SEGMENTATION": probes.Misc.HaveUDPSegmentation,
 	}
 
 	return writeFeatureHeader(writer, features, true)
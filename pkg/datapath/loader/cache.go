@@ -21,6 +21,7 @@ import (
 	"github.com/cilium/cilium/pkg/defaults"
 	"github.com/cilium/cilium/pkg/lock"
 	"github.com/cilium/cilium/pkg/logging/logfields"
+	promMetrics "github.com/cilium/cilium/pkg/metrics"
 )
 
 // objectCache amortises the cost of BPF compilation for endpoints.
@@ -143,6 +144,7 @@ func (o *objectCache) build(ctx context.Context, nodeCfg *datapath.LocalNodeConf
 	stats.BpfCompilation.Start()
 	err = compileDatapath(ctx, o.logger, dir, isHost)
 	stats.BpfCompilation.End(err == nil)
+	promMetrics.DatapathCompilations.WithLabelValues(promMetrics.Error2Outcome(err)).Inc()
 	if err != nil {
 		return "", fmt.Errorf("failed to compile template program: %w", err)
 	}
@@ -139,6 +139,25 @@ func removeEndpointRoute(ep datapath.Endpoint, ip net.IPNet) error {
 }
 
 func bpfMasqAddrs(ifName string, cfg *datapath.LocalNodeConfiguration) (masq4, masq6 netip.Addr) {
+	// Per-device overrides take precedence over the default selection. The
+	// override address must be assigned to the device, but may be a secondary
+	// address.
+	for _, override := range cfg.MasqSourceAddrs {
+		if override.Device != ifName {
+			continue
+		}
+		for _, addr := range cfg.NodeAddresses {
+			if addr.DeviceName != ifName || addr.Addr != override.Addr {
+				continue
+			}
+			if addr.Addr.Is4() {
+				masq4 = addr.Addr
+			} else {
+				masq6 = addr.Addr
+			}
+		}
+	}
+
 	if cfg.DeriveMasqIPAddrFromDevice != "" {
 		ifName = cfg.DeriveMasqIPAddrFromDevice
 	}
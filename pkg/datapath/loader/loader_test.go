@@ -21,6 +21,7 @@ import (
 	"github.com/cilium/cilium/pkg/bpf"
 	"github.com/cilium/cilium/pkg/datapath/loader/metrics"
 	"github.com/cilium/cilium/pkg/datapath/tables"
+	datapath "github.com/cilium/cilium/pkg/datapath/types"
 	"github.com/cilium/cilium/pkg/defaults"
 	"github.com/cilium/cilium/pkg/option"
 	"github.com/cilium/cilium/pkg/testutils"
@@ -248,6 +249,32 @@ func TestBPFMasqAddrs(t *testing.T) {
 	masq4, masq6 = bpfMasqAddrs("unknown", &newConfig)
 	require.Equal(t, "2.0.0.2", masq4.String())
 	require.Equal(t, "2000::2", masq6.String())
+
+	// A per-device override picks a secondary address of the device, the
+	// other address family falls back to the primary address.
+	newConfig.NodeAddresses = append(newConfig.NodeAddresses,
+		tables.NodeAddress{
+			Addr:       netip.MustParseAddr("1.0.0.2"),
+			NodePort:   false,
+			Primary:    false,
+			DeviceName: "test",
+		})
+	newConfig.MasqSourceAddrs = []datapath.MasqSourceAddr{
+		{Device: "test", Addr: netip.MustParseAddr("1.0.0.2")},
+	}
+
+	masq4, masq6 = bpfMasqAddrs("test", &newConfig)
+	require.Equal(t, "1.0.0.2", masq4.String())
+	require.Equal(t, "1000::1", masq6.String())
+
+	// Overrides for addresses not assigned to the device are ignored.
+	newConfig.MasqSourceAddrs = []datapath.MasqSourceAddr{
+		{Device: "test", Addr: netip.MustParseAddr("3.0.0.3")},
+	}
+
+	masq4, masq6 = bpfMasqAddrs("test", &newConfig)
+	require.Equal(t, "1.0.0.1", masq4.String())
+	require.Equal(t, "1000::1", masq6.String())
 }
 
 // BenchmarkCompileOnly benchmarks the just the entire compilation process.
@@ -109,6 +109,35 @@ func (ms *MapSweeper) CollectStaleMapGarbage() {
 	}
 }
 
+// RemoveObsoleteMaps removes BPF maps in the filesystem which were pinned by
+// previous versions of Cilium and are no longer created by any supported
+// version. Unlike a full --clean-cilium-bpf-state run, this only removes maps
+// known to be orphaned, leaving established state such as conntrack entries
+// intact.
+func (ms *MapSweeper) RemoveObsoleteMaps() {
+	maps := []string{
+		// Replaced by the v2 service maps.
+		"cilium_lb4_services",
+		"cilium_lb6_services",
+		// Weighted round-robin load balancing state, removed together
+		// with the feature.
+		"cilium_lb4_rr_seq",
+		"cilium_lb6_rr_seq",
+		"cilium_lb4_rr_seq_v2",
+		"cilium_lb6_rr_seq_v2",
+		// Used by the legacy proxy redirection datapath.
+		"cilium_proxy4",
+		"cilium_proxy6",
+	}
+
+	for _, m := range maps {
+		p := path.Join(bpf.TCGlobalsPath(), m)
+		if _, err := os.Stat(p); !os.IsNotExist(err) {
+			ms.RemoveMapPath(p)
+		}
+	}
+}
+
 // RemoveDisabledMaps removes BPF maps in the filesystem for features that have
 // been disabled. The maps may still be in use in which case they will continue
 // to live until the BPF program using them is being replaced.
@@ -120,14 +149,10 @@ func (ms *MapSweeper) RemoveDisabledMaps() {
 			"cilium_ct6_global",
 			"cilium_ct_any6_global",
 			"cilium_lb6_reverse_nat",
-			"cilium_lb6_rr_seq",
-			"cilium_lb6_services",
 			"cilium_lb6_services_v2",
-			"cilium_lb6_rr_seq_v2",
 			"cilium_lb6_backends_v2",
 			"cilium_lb6_reverse_sk",
 			"cilium_snat_v6_external",
-			"cilium_proxy6",
 			recorder.MapNameWcard6,
 			lbmap.MaglevOuter6MapName,
 			lbmap.Affinity6MapName,
@@ -144,14 +169,10 @@ func (ms *MapSweeper) RemoveDisabledMaps() {
 			"cilium_ct4_global",
 			"cilium_ct_any4_global",
 			"cilium_lb4_reverse_nat",
-			"cilium_lb4_rr_seq",
-			"cilium_lb4_services",
 			"cilium_lb4_services_v2",
-			"cilium_lb4_rr_seq_v2",
 			"cilium_lb4_backends_v2",
 			"cilium_lb4_reverse_sk",
 			"cilium_snat_v4_external",
-			"cilium_proxy4",
 			recorder.MapNameWcard4,
 			lbmap.MaglevOuter4MapName,
 			lbmap.Affinity4MapName,
@@ -6,6 +6,8 @@ package orchestrator
 import (
 	"context"
 	"fmt"
+	"net/netip"
+	"strings"
 
 	"github.com/cilium/statedb"
 
@@ -42,6 +44,7 @@ func newLocalNodeConfig(
 	devices statedb.Table[*tables.Device],
 	nodeAddresses statedb.Table[tables.NodeAddress],
 	masqInterface string,
+	masqSourceAddrs []datapath.MasqSourceAddr,
 	xdpConfig xdp.Config,
 	mtuTbl statedb.Table[mtu.RouteMTU],
 ) (datapath.LocalNodeConfiguration, <-chan struct{}, error) {
@@ -84,6 +87,7 @@ func newLocalNodeConfig(
 		NodeAddresses:                statedb.Collect(nodeAddrsIter),
 		DirectRoutingDevice:          directRoutingDevice,
 		DeriveMasqIPAddrFromDevice:   masqInterface,
+		MasqSourceAddrs:              masqSourceAddrs,
 		HostEndpointID:               node.GetEndpointID(),
 		DeviceMTU:                    mtuRoute.DeviceMTU,
 		RouteMTU:                     mtuRoute.RouteMTU,
@@ -103,3 +107,27 @@ func newLocalNodeConfig(
 		XDPConfig:                    xdpConfig,
 	}, common.MergeChannels(devsWatch, addrsWatch, directRoutingDevWatch, mtuWatch), nil
 }
+
+// parseMasqSourceAddrs parses the "device=address" pairs given via
+// --bpf-masq-source-addrs. At most one address per device and address family
+// may be specified.
+func parseMasqSourceAddrs(pairs []string) ([]datapath.MasqSourceAddr, error) {
+	var addrs []datapath.MasqSourceAddr
+	for _, pair := range pairs {
+		device, addrStr, found := strings.Cut(pair, "=")
+		if !found || device == "" {
+			return nil, fmt.Errorf("invalid masquerade source address %q, expected device=address", pair)
+		}
+		addr, err := netip.ParseAddr(addrStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid masquerade source address %q: %w", pair, err)
+		}
+		for _, other := range addrs {
+			if other.Device == device && other.Addr.Is4() == addr.Is4() {
+				return nil, fmt.Errorf("multiple masquerade source addresses of the same address family for device %q", device)
+			}
+		}
+		addrs = append(addrs, datapath.MasqSourceAddr{Device: device, Addr: addr})
+	}
+	return addrs, nil
+}
@@ -56,6 +56,11 @@ type Config struct {
 	// See commit d204d789746b1389cc2ba02fdd55b81a2f55b76e for original context.
 	// This can be removed once https://github.com/cilium/cilium/issues/17158 is resolved.
 	DeriveMasqIPAddrFromDevice string
+
+	// BPFMasqSourceAddrs selects, per device and address family, which of the
+	// device's addresses is used as the source IP for BPF masquerade instead
+	// of the primary address. Each entry is a "device=address" pair.
+	BPFMasqSourceAddrs []string
 }
 
 func (def Config) Flags(flags *pflag.FlagSet) {
@@ -64,6 +69,10 @@ func (def Config) Flags(flags *pflag.FlagSet) {
 		deriveFlag, def.DeriveMasqIPAddrFromDevice,
 		"Device name from which Cilium derives the IP addr for BPF masquerade")
 	flags.MarkHidden(deriveFlag)
+
+	flags.StringSlice(
+		"bpf-masq-source-addrs", def.BPFMasqSourceAddrs,
+		"Source addresses to use for BPF masquerade per device, as a list of device=address pairs (e.g. eth0=192.0.2.10). At most one address per device and address family")
 }
 
 type orchestrator struct {
@@ -181,6 +190,11 @@ func (o *orchestrator) reconciler(ctx context.Context, health cell.Health) error
 		return nil
 	}
 
+	masqSourceAddrs, err := parseMasqSourceAddrs(o.params.Config.BPFMasqSourceAddrs)
+	if err != nil {
+		return fmt.Errorf("failed to parse --bpf-masq-source-addrs: %w", err)
+	}
+
 	health.OK("Initializing")
 	limiter := rate.NewLimiter(minReinitInterval, 1)
 	var (
@@ -197,6 +211,7 @@ func (o *orchestrator) reconciler(ctx context.Context, health cell.Health) error
 			o.params.Devices,
 			o.params.NodeAddresses,
 			o.params.Config.DeriveMasqIPAddrFromDevice,
+			masqSourceAddrs,
 			o.params.XDPConfig,
 			o.params.MTU,
 		)
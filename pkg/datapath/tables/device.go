@@ -97,6 +97,7 @@ type Device struct {
 	RawFlags     uint32          // Raw interface flags
 	Type         string          // Device type, e.g. "veth" etc.
 	MasterIndex  int             // Index of the master device (e.g. bridge or bonding device)
+	NUMANode     int             // NUMA node of the underlying device, -1 if unknown or not applicable
 
 	Selected          bool   // True if this is an external facing device
 	NotSelectedReason string // Reason why this device was not selected
@@ -124,6 +125,7 @@ func (*Device) TableHeader() []string {
 		"Selected",
 		"Type",
 		"MTU",
+		"NUMANode",
 		"HWAddr",
 		"Flags",
 		"Addresses",
@@ -141,6 +143,7 @@ func (d *Device) TableRow() []string {
 		fmt.Sprintf("%v", d.Selected),
 		d.Type,
 		fmt.Sprintf("%d", d.MTU),
+		fmt.Sprintf("%d", d.NUMANode),
 		d.HardwareAddr.String(),
 		d.Flags.String(),
 		strings.Join(addrs, ", "),
@@ -6,6 +6,7 @@ package types
 import (
 	"context"
 	"net"
+	"net/netip"
 
 	"github.com/cilium/cilium/api/v1/models"
 	"github.com/cilium/cilium/pkg/cidr"
@@ -84,6 +85,13 @@ type LocalNodeConfiguration struct {
 	// the masquerading IP address for the node.
 	DeriveMasqIPAddrFromDevice string
 
+	// MasqSourceAddrs optionally overrides, per device and address family,
+	// which of the device's addresses is used as the source IP for BPF
+	// masquerading, e.g. a secondary/elastic IP rather than the primary
+	// address. Overrides referring to addresses not assigned to the device
+	// are ignored.
+	MasqSourceAddrs []MasqSourceAddr
+
 	// HostEndpointID is the endpoint ID assigned to the host endpoint.
 	// Immutable at runtime.
 	HostEndpointID uint64
@@ -182,6 +190,13 @@ type LocalNodeConfiguration struct {
 	XDPConfig xdp.Config
 }
 
+// MasqSourceAddr selects a specific address of a device to be used as the
+// source IP for BPF masquerading.
+type MasqSourceAddr struct {
+	Device string
+	Addr   netip.Addr
+}
+
 func (cfg *LocalNodeConfiguration) DeviceNames() []string {
 	return tables.DeviceNames(cfg.Devices)
 }
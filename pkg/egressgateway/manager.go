@@ -204,6 +204,15 @@ func NewEgressGatewayManager(p Params) (out struct {
 		return out, fmt.Errorf("egress gateway requires --%s=\"true\" and --%s=\"true\"", option.EnableIPv4Masquerade, option.EnableBPFMasquerade)
 	}
 
+	// Replies to egress-gatewayed flows must traverse the gateway again to be
+	// reverse SNATed. With DSR the service reply path bypasses the node that
+	// originated the flow, which breaks this assumption and leads to
+	// asymmetric routing failures that are hard to debug. Existing clusters
+	// run this combination, so warn rather than fail at startup.
+	if dcfg.LoadBalancerUsesDSR() {
+		p.Logger.Warn(fmt.Sprintf("Egress gateway with a DSR-based --%s does not preserve the return path of service replies through the gateway; egress-gatewayed connections established via services may break. Use --%s=%q to avoid this.", option.NodePortMode, option.NodePortMode, option.NodePortModeSNAT))
+	}
+
 	out.Manager, err = newEgressGatewayManager(p)
 	if err != nil {
 		return out, err
@@ -2341,6 +2341,11 @@ func (e *Endpoint) setPolicyRevision(rev uint64) {
 	e.UpdateLogger(map[string]any{
 		logfields.DatapathPolicyRevision: e.policyRevision,
 	})
+	// Record the transition in the endpoint's bounded status log, so that the
+	// time at which a given policy revision was realized by this endpoint can
+	// be recovered later, e.g. when investigating when a policy change took
+	// effect.
+	e.logStatusLocked(Policy, OK, fmt.Sprintf("Policy revision %d realized", rev))
 	for ps := range e.policyRevisionSignals {
 		select {
 		case <-ps.ctx.Done():
@@ -460,6 +460,9 @@ func TestWaitForPolicyRevision(t *testing.T) {
 		t.Fatalf("channel should have been closed since the wanted policy revision was reached")
 	}
 
+	// The realized policy revision is recorded in the status log.
+	require.Equal(t, "Policy revision 3 realized", e.status.CurrentStatuses[Policy].Status.Msg)
+
 	// Number of policy revision signals should be 0
 	require.Empty(t, e.policyRevisionSignals)
 
@@ -93,7 +93,11 @@ func (r *secretSyncer) upsertK8sSecretV1(ctx context.Context, secret *slim_corev
 
 	envoySecret := r.k8sToEnvoySecret(secret)
 	if envoySecret == nil {
-		return nil
+		// The Secret contains no data that can be delivered via SDS. Remove
+		// any previously delivered version so that Envoy doesn't keep
+		// serving stale certificates after the content has been emptied or
+		// replaced with unsupported keys.
+		return r.deleteK8sSecretV1(ctx, resource.NewKey(secret))
 	}
 
 	resource := Resources{
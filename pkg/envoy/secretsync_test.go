@@ -288,6 +288,14 @@ func TestHandleSecretEvent(t *testing.T) {
 			expectedUpserts:   1,
 			expectedDeletions: 0,
 		},
+		{
+			name:              "upserted secret without deliverable content should be deleted in xDS",
+			secret:            testSecretWithoutDeliverableContent(),
+			kind:              resource.Upsert,
+			expectedError:     false,
+			expectedUpserts:   0,
+			expectedDeletions: 1,
+		},
 		{
 			name:              "deleted secret should be deleted in xDS",
 			secret:            testSecret(),
@@ -393,6 +401,22 @@ func testSecret() *slim_corev1.Secret {
 	}
 }
 
+func testSecretWithoutDeliverableContent() *slim_corev1.Secret {
+	return &slim_corev1.Secret{
+		ObjectMeta: slim_metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      "test",
+		},
+		// Multiple unsupported keys don't qualify for the generic secret
+		// fallback and hence can't be delivered via SDS.
+		Data: map[string]slim_corev1.Bytes{
+			"unsupported1": []byte("content"),
+			"unsupported2": []byte("content"),
+		},
+		Type: "Opaque",
+	}
+}
+
 type fakeXdsServer struct {
 	nrOfDeletions int
 	returnError   bool
@@ -1218,9 +1218,15 @@ func toEnvoyOriginatingTLSContext(tls *policy.TLSContext, policySecretsNamespace
 		// If values are not present in these fields, then we should be using SDS,
 		// and Secret should be populated.
 		if tls.Secret.String() != "/" {
-			return &cilium.TLSContext{
+			tlsContext := &cilium.TLSContext{
 				ValidationContextSdsSecret: namespacedNametoSyncedSDSSecretName(tls.Secret, policySecretsNamespace),
 			}
+			// When the policy explicitly selected a client certificate from the
+			// Secret, have Envoy present it on the upstream connection (mTLS).
+			if tls.PresentClientCert {
+				tlsContext.TlsSdsSecret = namespacedNametoSyncedSDSSecretName(tls.Secret, policySecretsNamespace)
+			}
+			return tlsContext
 		}
 		// This code _should_ be unreachable, because NetworkPolicy input validation does not allow
 		// the Secret fields to be empty, so panic.
@@ -1237,9 +1243,17 @@ func toEnvoyOriginatingTLSContext(tls *policy.TLSContext, policySecretsNamespace
 		}
 	}
 
-	return &cilium.TLSContext{
+	tlsContext := &cilium.TLSContext{
 		TrustedCa: tls.TrustedCA,
 	}
+	// Unlike useFullTLSContext, the client certificate is only included when the
+	// policy explicitly selected one, so a stray 'tls.crt' in the Secret does not
+	// make Envoy present it to the remote peer.
+	if tls.PresentClientCert {
+		tlsContext.CertificateChain = tls.CertificateChain
+		tlsContext.PrivateKey = tls.PrivateKey
+	}
+	return tlsContext
 }
 
 // toEnvoyTerminatingTLSContext converts a "policy" TLS context (i.e., from a CiliumNetworkPolicy or
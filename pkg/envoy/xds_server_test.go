@@ -844,6 +844,72 @@ func TestGetNetworkPolicyMySQL(t *testing.T) {
 	require.Equal(t, expected, obtained)
 }
 
+var L4PolicyPostgres = &policy.L4Policy{
+	Egress: policy.L4DirectionPolicy{PortRules: policy.NewL4PolicyMapWithValues(map[string]*policy.L4Filter{
+		"5432/TCP": {
+			Port: 5432, Protocol: api.ProtoTCP,
+			PerSelectorPolicies: policy.L7DataMap{
+				cachedSelector1: &policy.PerSelectorPolicy{
+					L7Parser: "envoy.filters.network.postgres_proxy",
+					L7Rules: api.L7Rules{
+						L7Proto: "envoy.filters.network.postgres_proxy",
+						L7: []api.PortRuleL7{
+							map[string]string{
+								// Empty value matches on presence of the
+								// metadata key, regardless of its value.
+								"statement_select": "",
+							},
+						},
+					},
+				},
+			},
+			Ingress: false,
+		},
+	})},
+}
+
+var ExpectedPerPortPoliciesPostgres = []*cilium.PortNetworkPolicy{
+	{
+		Port:     5432,
+		Protocol: envoy_config_core.SocketAddress_TCP,
+		Rules: []*cilium.PortNetworkPolicyRule{
+			{
+				// RemotePolicies: []uint32{1001, 1002}, // Effective wildcard due to only one selector in the policy
+				L7Proto: "envoy.filters.network.postgres_proxy",
+				L7: &cilium.PortNetworkPolicyRule_L7Rules{
+					L7Rules: &cilium.L7NetworkPolicyRules{
+						L7AllowRules: []*cilium.L7NetworkPolicyRule{{
+							MetadataRule: []*envoy_type_matcher.MetadataMatcher{{
+								Filter: "envoy.filters.network.postgres_proxy",
+								Path: []*envoy_type_matcher.MetadataMatcher_PathSegment{{
+									Segment: &envoy_type_matcher.MetadataMatcher_PathSegment_Key{Key: "statement_select"},
+								}},
+								Value: &envoy_type_matcher.ValueMatcher{
+									MatchPattern: &envoy_type_matcher.ValueMatcher_PresentMatch{
+										PresentMatch: true,
+									},
+								},
+							}},
+						}},
+					},
+				},
+			},
+		},
+	},
+}
+
+func TestGetNetworkPolicyPostgres(t *testing.T) {
+	xds := testXdsServer(t)
+	obtained := xds.getNetworkPolicy(ep, []string{IPv4Addr}, L4PolicyPostgres, true, true, false, false, "")
+	expected := &cilium.NetworkPolicy{
+		EndpointIps:           []string{IPv4Addr},
+		EndpointId:            uint64(ep.GetID()),
+		EgressPerPortPolicies: ExpectedPerPortPoliciesPostgres,
+		ConntrackMapName:      "global",
+	}
+	require.Equal(t, expected, obtained)
+}
+
 var fullValuesTLSContext = &policy.TLSContext{
 	TrustedCA:        "foo",
 	CertificateChain: "certchain",
@@ -862,6 +928,17 @@ var onlyTrustedCAOriginatingTLSContext = &policy.TLSContext{
 	},
 }
 
+var clientCertOriginatingTLSContext = &policy.TLSContext{
+	TrustedCA:         "foo",
+	CertificateChain:  "certchain",
+	PrivateKey:        "privatekey",
+	PresentClientCert: true,
+	Secret: types.NamespacedName{
+		Name:      "testsecret",
+		Namespace: "testnamespace",
+	},
+}
+
 var onlyTerminationDetailsTLSContext = &policy.TLSContext{
 	CertificateChain: "certchain",
 	PrivateKey:       "privatekey",
@@ -926,6 +1003,8 @@ var L4PolicyTLSEgressOnlyTrustedCA = newL4PolicyTLSEgress(onlyTrustedCAOriginati
 
 var L4PolicyTLSEgressOnlyTrustedCAFromFile = newL4PolicyTLSEgress(onlyTrustedCAOriginatingTLSContextFromFile)
 
+var L4PolicyTLSEgressClientCert = newL4PolicyTLSEgress(clientCertOriginatingTLSContext)
+
 func newEgressPortNetworkPolicyReturnVal(tls *cilium.TLSContext) []*cilium.PortNetworkPolicy {
 	return []*cilium.PortNetworkPolicy{
 		{
@@ -967,6 +1046,15 @@ var ExpectedPerPortPoliciesTLSEgressNoSync = newEgressPortNetworkPolicyReturnVal
 
 var ExpectedPerPortPoliciesTLSEgressNoSyncUseFullContext = newEgressPortNetworkPolicyReturnVal(ciliumTLSContextAllDetails)
 
+var ciliumTLSContextClientCertSDSDetails = &cilium.TLSContext{
+	ValidationContextSdsSecret: "cilium-secrets/testnamespace-testsecret",
+	TlsSdsSecret:               "cilium-secrets/testnamespace-testsecret",
+}
+
+var ExpectedPerPortPoliciesTLSEgressClientCert = newEgressPortNetworkPolicyReturnVal(ciliumTLSContextClientCertSDSDetails)
+
+var ExpectedPerPortPoliciesTLSEgressClientCertNoSync = newEgressPortNetworkPolicyReturnVal(ciliumTLSContextAllDetails)
+
 func newL4PolicyTLSIngress(tls *policy.TLSContext) *policy.L4Policy {
 	return &policy.L4Policy{
 		Ingress: policy.L4DirectionPolicy{PortRules: policy.NewL4PolicyMapWithValues(map[string]*policy.L4Filter{
@@ -1215,6 +1303,28 @@ func TestGetNetworkPolicyTLSInterception(t *testing.T) {
 			wantEgress:  ExpectedPerPortPoliciesTLSEgressNoSync,
 			wantIngress: nil,
 		},
+		{
+			name: "Egress Originating TLS with client certificate with secret sync",
+			args: args{
+				inputPolicy:            L4PolicyTLSEgressClientCert,
+				useFullTLSContext:      false,
+				useSDS:                 true,
+				policySecretsNamespace: "cilium-secrets",
+			},
+			wantEgress:  ExpectedPerPortPoliciesTLSEgressClientCert,
+			wantIngress: nil,
+		},
+		{
+			name: "Egress Originating TLS with client certificate, no sync",
+			args: args{
+				inputPolicy:            L4PolicyTLSEgressClientCert,
+				useFullTLSContext:      false,
+				useSDS:                 false,
+				policySecretsNamespace: "",
+			},
+			wantEgress:  ExpectedPerPortPoliciesTLSEgressClientCertNoSync,
+			wantIngress: nil,
+		},
 		{
 			name: "Egress Originating TLS Fully Populated with secret sync, fromFile",
 			args: args{
@@ -302,6 +302,7 @@ func (b *dnsRequestHandler) NotifyOnDNSMsg(
 		accesslog.LogTags.Addressing(logContext, addrInfo),
 		accesslog.LogTags.DNS(&accesslog.LogRecordDNS{
 			Query:             qname,
+			TransactionID:     msg.Id,
 			IPs:               responseIPs,
 			TTL:               TTL,
 			CNAMEs:            CNAMEs,
@@ -38,7 +38,7 @@ func TestSetPortRulesForID(t *testing.T) {
 		},
 	}
 
-	err := pea.setPortRulesForID(cache, epID, udpProtoPort8053, rules)
+	err := pea.setPortRulesForID(cache, epID, udpProtoPort8053, rules, GeneratePattern)
 	require.NoError(t, err)
 	require.Len(t, cache, 1)
 
@@ -53,16 +53,16 @@ func TestSetPortRulesForID(t *testing.T) {
 		},
 	}
 
-	err = pea.setPortRulesForID(cache, epID, udpProtoPort8053, rules)
+	err = pea.setPortRulesForID(cache, epID, udpProtoPort8053, rules, GeneratePattern)
 	require.NoError(t, err)
 	require.Len(t, cache, 2)
 
 	delete(rules, selector2)
-	err = pea.setPortRulesForID(cache, epID, udpProtoPort8053, rules)
+	err = pea.setPortRulesForID(cache, epID, udpProtoPort8053, rules, GeneratePattern)
 	require.NoError(t, err)
 	require.Len(t, cache, 1)
 
-	err = pea.setPortRulesForID(cache, epID, udpProtoPort8053, nil)
+	err = pea.setPortRulesForID(cache, epID, udpProtoPort8053, nil, GeneratePattern)
 	require.NoError(t, err)
 	require.Empty(t, cache)
 
@@ -76,7 +76,7 @@ func TestSetPortRulesForID(t *testing.T) {
 			},
 		},
 	}
-	err = pea.setPortRulesForID(cache, epID, udpProtoPort8053, rules)
+	err = pea.setPortRulesForID(cache, epID, udpProtoPort8053, rules, GeneratePattern)
 
 	require.Error(t, err)
 	require.Empty(t, cache)
@@ -182,6 +182,49 @@ func TestGeneratePattern(t *testing.T) {
 	}
 }
 
+func TestGenerateDenyPattern(t *testing.T) {
+	l7 := &policy.PerSelectorPolicy{
+		L7Rules: api.L7Rules{DNS: []api.PortRuleDNS{
+			{MatchPattern: "*", ResponsePolicy: api.DNSResponsePolicyAllow},
+			{MatchName: "blocked.example.com.", ResponsePolicy: api.DNSResponsePolicyDeny},
+			{MatchPattern: "*.blocked.io", ResponsePolicy: api.DNSResponsePolicyDeny},
+		}},
+	}
+	denied := []string{"blocked.example.com.", "sub.blocked.io."}
+	allowed := []string{"example.com.", "blocked.io.", "cilium.io."}
+
+	re.InitRegexCompileLRU(defaults.FQDNRegexCompileLRUSize)
+	pattern := GenerateDenyPattern(l7)
+
+	regex, err := re.CompileRegex(pattern)
+	require.NoError(t, err)
+
+	for _, fqdn := range denied {
+		require.Truef(t, regex.MatchString(fqdn), "expected fqdn %q to match the deny pattern, but it did not", fqdn)
+	}
+	for _, fqdn := range allowed {
+		require.Falsef(t, regex.MatchString(fqdn), "expected fqdn %q to not match the deny pattern, but it did", fqdn)
+	}
+
+	// Deny rules must not leak into the allow pattern.
+	pattern = GeneratePattern(l7)
+	regex, err = re.CompileRegex(pattern)
+	require.NoError(t, err)
+
+	for _, fqdn := range append(denied, allowed...) {
+		require.Truef(t, regex.MatchString(fqdn), "expected fqdn %q to match the wildcard allow pattern, but it did not", fqdn)
+	}
+
+	// No deny rules yields no deny pattern.
+	pattern = GenerateDenyPattern(&policy.PerSelectorPolicy{
+		L7Rules: api.L7Rules{DNS: []api.PortRuleDNS{
+			{MatchName: "cilium.io."},
+		}},
+	})
+	require.Empty(t, pattern)
+	require.Empty(t, GenerateDenyPattern(nil))
+}
+
 func TestGeneratePatternTrailingDot(t *testing.T) {
 	dnsName := "example.name"
 	dnsPattern := "*.example.name"
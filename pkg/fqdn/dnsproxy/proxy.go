@@ -139,6 +139,12 @@ type DNSProxy struct {
 	// Note: Simple DNS names, e.g. bar.foo.com, will treat the "." as a literal.
 	allowed perEPAllow
 
+	// denied tracks L7 DNS rules with a deny response policy by endpointID,
+	// destination port, and L3 Selector. A query matching a denied rule is
+	// refused with an NXDOMAIN response before being checked against
+	// 'allowed'.
+	denied perEPAllow
+
 	// Current rules enforced by 'allowed', used for reverting
 	currentRules perEPPolicy
 
@@ -509,8 +515,10 @@ func (allow perEPAllow) removeAndReleasePortRulesForID(cache regexCache, endpoin
 }
 
 // setPortRulesForID sets the matching rules for endpointID and destPort for
-// later lookups. It converts newRules into a compiled regex
-func (allow perEPAllow) setPortRulesForID(cache regexCache, endpointID uint64, destPortProto restore.PortProto, newRules policy.L7DataMap) error {
+// later lookups. It converts newRules into a compiled regex using the
+// provided pattern generator. Selectors for which the generator returns an
+// empty pattern are skipped.
+func (allow perEPAllow) setPortRulesForID(cache regexCache, endpointID uint64, destPortProto restore.PortProto, newRules policy.L7DataMap, generatePattern func(*policy.PerSelectorPolicy) string) error {
 	if len(newRules) == 0 {
 		allow.removeAndReleasePortRulesForID(cache, endpointID, destPortProto)
 		return nil
@@ -518,7 +526,10 @@ func (allow perEPAllow) setPortRulesForID(cache regexCache, endpointID uint64, d
 	cse := make(CachedSelectorREEntry, len(newRules))
 	var err error
 	for selector, newRuleset := range newRules {
-		pattern := GeneratePattern(newRuleset)
+		pattern := generatePattern(newRuleset)
+		if pattern == "" {
+			continue
+		}
 
 		var regex *regexp.Regexp
 		regex, err = cache.lookupOrCompileRegex(pattern)
@@ -537,6 +548,9 @@ func (allow perEPAllow) setPortRulesForID(cache regexCache, endpointID uint64, d
 		return err
 	}
 	allow.removeAndReleasePortRulesForID(cache, endpointID, destPortProto)
+	if len(cse) == 0 {
+		return nil
+	}
 	epPortProtos, exist := allow[endpointID]
 	if !exist {
 		epPortProtos = make(portProtoToSelectorAllow)
@@ -718,6 +732,7 @@ func NewDNSProxy(
 		lookupTargetDNSServer:    lookupTargetDNSServer,
 		usedServers:              make(map[netip.Addr]struct{}),
 		allowed:                  make(perEPAllow),
+		denied:                   make(perEPAllow),
 		currentRules:             make(perEPPolicy),
 		restored:                 make(perEPRestored),
 		restoredEPs:              make(restoredEPs),
@@ -818,7 +833,11 @@ func (p *DNSProxy) UpdateAllowed(endpointID uint64, destPortProto restore.PortPr
 	p.Lock()
 	defer p.Unlock()
 
-	err := p.allowed.setPortRulesForID(p.cache, endpointID, destPortProto, newRules)
+	err := p.allowed.setPortRulesForID(p.cache, endpointID, destPortProto, newRules, GeneratePattern)
+	if err != nil {
+		return nil, err
+	}
+	err = p.denied.setPortRulesForID(p.cache, endpointID, destPortProto, newRules, GenerateDenyPattern)
 	if err != nil {
 		return nil, err
 	}
@@ -833,7 +852,10 @@ func (p *DNSProxy) UpdateAllowed(endpointID uint64, destPortProto restore.PortPr
 	revert := func() error {
 		p.Lock()
 		defer p.Unlock()
-		return p.allowed.setPortRulesForID(p.cache, endpointID, destPortProto, oldRules)
+		if err := p.allowed.setPortRulesForID(p.cache, endpointID, destPortProto, oldRules, GeneratePattern); err != nil {
+			return err
+		}
+		return p.denied.setPortRulesForID(p.cache, endpointID, destPortProto, oldRules, GenerateDenyPattern)
 	}
 	return revert, nil
 }
@@ -844,6 +866,8 @@ func (p *DNSProxy) UpdateAllowedFromSelectorRegexes(endpointID uint64, destPortP
 	defer p.Unlock()
 
 	p.allowed.setPortRulesForIDFromUnifiedFormat(p.cache, endpointID, destPortProto, newRules)
+	// The unified format carries no response policy, drop any deny rules
+	p.denied.removeAndReleasePortRulesForID(p.cache, endpointID, destPortProto)
 	// Rules were updated based on policy, remove restored rules
 	p.removeRestoredRulesLocked(endpointID)
 	return nil
@@ -872,6 +896,29 @@ func (p *DNSProxy) CheckAllowed(endpointID uint64, destPortProto restore.PortPro
 	return false, nil
 }
 
+// CheckDenied checks endpointID, destPortProto, destID, destIP, and name
+// against the rules with a deny response policy added to the proxy, and
+// returns true if a matching rule refuses resolution of name.
+func (p *DNSProxy) CheckDenied(endpointID uint64, destPortProto restore.PortProto, destID identity.NumericIdentity, destIP netip.Addr, name string) bool {
+	name = strings.ToLower(dns.Fqdn(name))
+	p.RLock()
+	defer p.RUnlock()
+
+	epDeny, exists := p.denied.getPortRulesForID(endpointID, destPortProto)
+	if !exists {
+		return false
+	}
+
+	for selector, regex := range epDeny {
+		// The port was matched in getPortRulesForID, above.
+		if regex != nil && selector.Selects(versioned.Latest(), destID) && (regex.String() == matchpattern.MatchAllAnchoredPattern || regex.MatchString(name)) {
+			return true
+		}
+	}
+
+	return false
+}
+
 // setSoMarks sets the socket options needed for a transparent proxy to integrate it's upstream
 // (forwarded) connection with Cilium datapath. Some considerations for this design:
 //
@@ -1074,6 +1121,7 @@ func (p *DNSProxy) ServeDNS(w dns.ResponseWriter, request *dns.Msg) {
 	// it won't enforce any separation between results from different endpoints.
 	// This isn't ideal but we are trusting the DNS responses anyway.
 	stat.PolicyCheckTime.Start()
+	denied := p.CheckDenied(uint64(ep.ID), targetServerPortProto, targetServerID, targetServer.Addr(), qname)
 	allowed, err := p.CheckAllowed(uint64(ep.ID), targetServerPortProto, targetServerID, targetServer.Addr(), qname)
 	stat.PolicyCheckTime.End(err == nil)
 	switch {
@@ -1085,6 +1133,16 @@ func (p *DNSProxy) ServeDNS(w dns.ResponseWriter, request *dns.Msg) {
 		p.sendErrorResponse(scopedLog, w, request, false)
 		return
 
+	case denied:
+		scopedLog.Debug("Refusing resolution of DNS name denied by policy")
+		// Deny response policies always answer with NXDOMAIN, independently
+		// of the configured reject reply, so that the client treats the name
+		// as non-existent rather than retrying another resolver.
+		stat.Err = p.sendDeniedResponse(scopedLog, w, request)
+		stat.ProcessingTime.End(true)
+		p.NotifyOnDNSMsg(time.Now(), ep, epIPPort, targetServerID, targetServer, request, protocol, false, &stat)
+		return
+
 	case !allowed:
 		scopedLog.Debug("Rejecting DNS query from endpoint due to policy")
 		// Send refused msg before calling NotifyOnDNSMsg() because we know
@@ -1246,6 +1304,20 @@ func (p *DNSProxy) sendErrorResponse(scopedLog *logrus.Entry, w dns.ResponseWrit
 	return err
 }
 
+// sendDeniedResponse creates and sends an NXDOMAIN response for request to w.
+// It is used when a rule with a deny response policy refuses resolution of
+// the requested name, independently of the configured rejectReply option.
+func (p *DNSProxy) sendDeniedResponse(scopedLog *logrus.Entry, w dns.ResponseWriter, request *dns.Msg) (err error) {
+	response := new(dns.Msg)
+	response.SetRcode(request, dns.RcodeNameError)
+
+	if err = w.WriteMsg(response); err != nil {
+		scopedLog.WithError(err).Error("Cannot send deny response")
+		err = fmt.Errorf("cannot send deny response: %w", err)
+	}
+	return err
+}
+
 // SetRejectReply sets the default reject reply on denied dns responses.
 func (p *DNSProxy) SetRejectReply(opt string) {
 	switch strings.ToLower(opt) {
@@ -1478,13 +1550,46 @@ func shouldCompressResponse(request, response *dns.Msg) bool {
 }
 
 // GeneratePattern takes a set of l7Rules and returns a regular expression pattern for matching the
-// provided l7 rules.
+// provided l7 rules. Rules with a deny response policy are not part of the
+// pattern; they are compiled separately by GenerateDenyPattern.
 func GeneratePattern(l7Rules *policy.PerSelectorPolicy) (pattern string) {
 	if l7Rules == nil || len(l7Rules.DNS) == 0 {
 		return matchpattern.MatchAllAnchoredPattern
 	}
 	reStrings := make([]string, 0, len(l7Rules.DNS))
 	for _, dnsRule := range l7Rules.DNS {
+		if dnsRule.IsDeny() {
+			continue
+		}
+		if len(dnsRule.MatchName) > 0 {
+			dnsRuleName := strings.ToLower(dns.Fqdn(dnsRule.MatchName))
+			dnsRuleNameAsRE := matchpattern.ToUnAnchoredRegexp(dnsRuleName)
+			reStrings = append(reStrings, dnsRuleNameAsRE)
+		}
+		if len(dnsRule.MatchPattern) > 0 {
+			dnsPattern := matchpattern.Sanitize(dnsRule.MatchPattern)
+			dnsPatternAsRE := matchpattern.ToUnAnchoredRegexp(dnsPattern)
+			if dnsPatternAsRE == matchpattern.MatchAllUnAnchoredPattern {
+				return matchpattern.MatchAllAnchoredPattern
+			}
+			reStrings = append(reStrings, dnsPatternAsRE)
+		}
+	}
+	return "^(?:" + strings.Join(reStrings, "|") + ")$"
+}
+
+// GenerateDenyPattern takes a set of l7Rules and returns a regular expression
+// pattern matching the DNS names for which resolution is refused. It returns
+// the empty string when no rule carries a deny response policy.
+func GenerateDenyPattern(l7Rules *policy.PerSelectorPolicy) (pattern string) {
+	if l7Rules == nil || len(l7Rules.DNS) == 0 {
+		return ""
+	}
+	reStrings := make([]string, 0, len(l7Rules.DNS))
+	for _, dnsRule := range l7Rules.DNS {
+		if !dnsRule.IsDeny() {
+			continue
+		}
 		if len(dnsRule.MatchName) > 0 {
 			dnsRuleName := strings.ToLower(dns.Fqdn(dnsRule.MatchName))
 			dnsRuleNameAsRE := matchpattern.ToUnAnchoredRegexp(dnsRuleName)
@@ -1499,6 +1604,9 @@ func GeneratePattern(l7Rules *policy.PerSelectorPolicy) (pattern string) {
 			reStrings = append(reStrings, dnsPatternAsRE)
 		}
 	}
+	if len(reStrings) == 0 {
+		return ""
+	}
 	return "^(?:" + strings.Join(reStrings, "|") + ")$"
 }
 
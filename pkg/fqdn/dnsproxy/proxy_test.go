@@ -1263,14 +1263,14 @@ func Benchmark_perEPAllow_setPortRulesForID(b *testing.B) {
 
 	for b.Loop() {
 		for epID := uint64(0); epID < nEPs; epID++ {
-			pea.setPortRulesForID(c, epID, udpProtoPort8053, nil)
+			pea.setPortRulesForID(c, epID, udpProtoPort8053, nil, GeneratePattern)
 		}
 		b.StartTimer()
 		for epID, rules := range rulesPerEP {
 			if epID >= nEPsAtOnce {
-				pea.setPortRulesForID(c, uint64(epID)-nEPsAtOnce, udpProtoPort8053, nil)
+				pea.setPortRulesForID(c, uint64(epID)-nEPsAtOnce, udpProtoPort8053, nil, GeneratePattern)
 			}
-			pea.setPortRulesForID(c, uint64(epID), udpProtoPort8053, rules)
+			pea.setPortRulesForID(c, uint64(epID), udpProtoPort8053, rules, GeneratePattern)
 		}
 		b.StopTimer()
 	}
@@ -1280,7 +1280,7 @@ func Benchmark_perEPAllow_setPortRulesForID(b *testing.B) {
 	b.ReportMetric(float64(getMemStats().HeapInuse-initialHeap), "B(HeapInUse)/op")
 
 	for epID := uint64(0); epID < nEPs; epID++ {
-		pea.setPortRulesForID(c, epID, udpProtoPort8053, nil)
+		pea.setPortRulesForID(c, epID, udpProtoPort8053, nil, GeneratePattern)
 	}
 	if len(pea) > 0 {
 		b.Fail()
@@ -1288,7 +1288,7 @@ func Benchmark_perEPAllow_setPortRulesForID(b *testing.B) {
 	b.StopTimer()
 	// Remove all the inserted rules to ensure the cache goes down to zero entries
 	for epID := uint64(0); epID < 20; epID++ {
-		pea.setPortRulesForID(c, epID, udpProtoPort8053, nil)
+		pea.setPortRulesForID(c, epID, udpProtoPort8053, nil, GeneratePattern)
 	}
 	if len(pea) > 0 || len(c) > 0 {
 		b.Fail()
@@ -1379,7 +1379,7 @@ func Benchmark_perEPAllow_setPortRulesForID_large(b *testing.B) {
 
 	for b.Loop() {
 		for epID := uint64(0); epID < numEPs; epID++ {
-			pea.setPortRulesForID(c, epID, udpProtoPort8053, rules)
+			pea.setPortRulesForID(c, epID, udpProtoPort8053, rules, GeneratePattern)
 		}
 	}
 	b.StopTimer()
@@ -1401,7 +1401,7 @@ func Benchmark_perEPAllow_setPortRulesForID_large(b *testing.B) {
 	fmt.Printf("\tNumGC = %v\n", m.NumGC)
 	// Remove all the inserted rules to ensure both indexes go to zero entries
 	for epID := uint64(0); epID < numEPs; epID++ {
-		pea.setPortRulesForID(c, epID, udpProtoPort8053, nil)
+		pea.setPortRulesForID(c, epID, udpProtoPort8053, nil, GeneratePattern)
 	}
 	if len(pea) > 0 || len(c) > 0 {
 		b.Fail()
@@ -39,6 +39,10 @@ type Config struct {
 	// ExportFileMaxSizeMB specifies the file size in MB at which to rotate the
 	// Hubble export file.
 	ExportFileMaxSizeMB int `mapstructure:"hubble-export-file-max-size-mb"`
+	// ExportFileMaxAgeDays specifies the number of days after which rotated
+	// files are deleted. The default is to not delete rotated files based on
+	// age.
+	ExportFileMaxAgeDays int `mapstructure:"hubble-export-file-max-age-days"`
 	// ExportFileMaxBackups specifies the number of rotated files to keep.
 	ExportFileMaxBackups int `mapstructure:"hubble-export-file-max-backups"`
 	// ExportFileCompress specifies whether rotated files are compressed.
@@ -55,6 +59,7 @@ var DefaultConfig = Config{
 	FlowlogsConfigFilePath: "",
 	ExportFilePath:         "",
 	ExportFileMaxSizeMB:    exporter.DefaultFileMaxSizeMB,
+	ExportFileMaxAgeDays:   0,
 	ExportFileMaxBackups:   exporter.DefaultFileMaxBackups,
 	ExportFileCompress:     false,
 	ExportAllowlist:        "",
@@ -66,6 +71,7 @@ func (def Config) Flags(flags *pflag.FlagSet) {
 	flags.String("hubble-flowlogs-config-path", def.FlowlogsConfigFilePath, "Filepath with configuration of hubble flowlogs")
 	flags.String("hubble-export-file-path", def.ExportFilePath, "Filepath to write Hubble events to. By specifying `stdout` the flows are logged instead of written to a rotated file.")
 	flags.Int("hubble-export-file-max-size-mb", def.ExportFileMaxSizeMB, "Size in MB at which to rotate Hubble export file.")
+	flags.Int("hubble-export-file-max-age-days", def.ExportFileMaxAgeDays, "Number of days after which rotated Hubble export files are deleted. A value of 0 disables age based deletion.")
 	flags.Int("hubble-export-file-max-backups", def.ExportFileMaxBackups, "Number of rotated Hubble export files to keep.")
 	flags.Bool("hubble-export-file-compress", def.ExportFileCompress, "Compress rotated Hubble export files.")
 	flags.String("hubble-export-allowlist", "", "Specify allowlist as JSON encoded FlowFilters to Hubble exporter.")
@@ -138,6 +144,7 @@ func newHubbleStaticExporter(params hubbleExportersParams) (hubbleExportersOut,
 				exporterOpts = append(exporterOpts, exporter.WithNewWriterFunc(exporter.FileWriter(exporter.FileWriterConfig{
 					Filename:   params.Config.ExportFilePath,
 					MaxSize:    params.Config.ExportFileMaxSizeMB,
+					MaxAge:     params.Config.ExportFileMaxAgeDays,
 					MaxBackups: params.Config.ExportFileMaxBackups,
 					Compress:   params.Config.ExportFileCompress,
 				})))
@@ -93,6 +93,7 @@ func (f *exporterFactory) create(config *FlowLogConfig) (*exporter, error) {
 		exporterOpts = append(exporterOpts, WithNewWriterFunc(FileWriter(FileWriterConfig{
 			Filename:   config.FilePath,
 			MaxSize:    fileMaxSizeMB,
+			MaxAge:     config.FileMaxAgeDays,
 			MaxBackups: fileMaxBackups,
 			Compress:   config.FileCompress,
 		})))
@@ -150,6 +151,7 @@ type FlowLogConfig struct {
 	IncludeFilters FlowFilters `json:"includeFilters,omitempty" yaml:"includeFilters,omitempty"`
 	ExcludeFilters FlowFilters `json:"excludeFilters,omitempty" yaml:"excludeFilters,omitempty"`
 	FileMaxSizeMB  int         `json:"fileMaxSizeMb,omitempty" yaml:"fileMaxSizeMb,omitempty"`
+	FileMaxAgeDays int         `json:"fileMaxAgeDays,omitempty" yaml:"fileMaxAgeDays,omitempty"`
 	FileMaxBackups int         `json:"fileMaxBackups,omitempty" yaml:"fileMaxBackups,omitempty"`
 	FileCompress   bool        `json:"fileCompress,omitempty" yaml:"fileCompress,omitempty"`
 	End            *time.Time  `json:"end,omitempty" yaml:"end,omitempty"`
@@ -196,6 +198,10 @@ func (f *FlowLogConfig) equals(other *FlowLogConfig) bool {
 		return false
 	}
 
+	if f.FileMaxAgeDays != other.FileMaxAgeDays {
+		return false
+	}
+
 	if f.FileMaxBackups != other.FileMaxBackups {
 		return false
 	}
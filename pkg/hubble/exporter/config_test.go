@@ -70,6 +70,18 @@ func TestCompareFlowLogConfigs(t *testing.T) {
 			newConfig:     &FlowLogConfig{FieldMask: []string{"c", "b"}},
 			expectEqual:   false,
 		},
+		{
+			name:          "should equal for same max age",
+			currentConfig: &FlowLogConfig{FileMaxAgeDays: 7},
+			newConfig:     &FlowLogConfig{FileMaxAgeDays: 7},
+			expectEqual:   true,
+		},
+		{
+			name:          "should not equal for different max age",
+			currentConfig: &FlowLogConfig{FileMaxAgeDays: 7},
+			newConfig:     &FlowLogConfig{FileMaxAgeDays: 30},
+			expectEqual:   false,
+		},
 		{
 			name: "should equal for same include filters in different order",
 			currentConfig: &FlowLogConfig{IncludeFilters: FlowFilters{
@@ -332,6 +344,7 @@ func TestYamlConfigFileUnmarshalling(t *testing.T) {
 			},
 			ExcludeFilters: FlowFilters{},
 			FileMaxSizeMB:  10,
+			FileMaxAgeDays: 7,
 			FileMaxBackups: 3,
 			FileCompress:   true,
 			End:            &expectedDate,
@@ -58,8 +58,11 @@ func FileWriter(config FileWriterConfig) func() (io.WriteCloser, error) {
 		return &lumberjack.Logger{
 			Filename:   config.Filename,
 			MaxSize:    config.MaxSize,
+			MaxAge:     config.MaxAge,
 			MaxBackups: config.MaxBackups,
+			LocalTime:  config.LocalTime,
 			Compress:   config.Compress,
+			FileMode:   config.FileMode,
 		}, nil
 	}
 }
@@ -0,0 +1,45 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Hubble
+
+package api
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	pb "github.com/cilium/cilium/api/v1/flow"
+)
+
+// FlowExemplar returns exemplar labels linking a metric sample to the given
+// flow. The trace ID is preferred so that metrics can be correlated with
+// distributed traces; if the flow carries no trace context, the flow UUID is
+// used instead, which can be looked up in Hubble directly.
+func FlowExemplar(flow *pb.Flow) prometheus.Labels {
+	if traceID := flow.GetTraceContext().GetParent().GetTraceId(); traceID != "" {
+		return prometheus.Labels{"traceID": traceID}
+	}
+	if uuid := flow.GetUuid(); uuid != "" {
+		return prometheus.Labels{"flowUUID": uuid}
+	}
+	return nil
+}
+
+// CounterInc increments the given counter, attaching the exemplar labels if
+// the underlying implementation supports exemplars and labels are provided.
+func CounterInc(c prometheus.Counter, exemplar prometheus.Labels) {
+	if adder, ok := c.(prometheus.ExemplarAdder); ok && exemplar != nil {
+		adder.AddWithExemplar(1, exemplar)
+	} else {
+		c.Inc()
+	}
+}
+
+// ObserverObserve records the given value on the observer, attaching the
+// exemplar labels if the underlying implementation supports exemplars and
+// labels are provided.
+func ObserverObserve(o prometheus.Observer, value float64, exemplar prometheus.Labels) {
+	if observer, ok := o.(prometheus.ExemplarObserver); ok && exemplar != nil {
+		observer.ObserveWithExemplar(value, exemplar)
+	} else {
+		o.Observe(value)
+	}
+}
@@ -0,0 +1,169 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package connection_errors
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/gopacket/gopacket/layers"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	flowpb "github.com/cilium/cilium/api/v1/flow"
+	v1 "github.com/cilium/cilium/pkg/hubble/api/v1"
+	"github.com/cilium/cilium/pkg/hubble/filters"
+	"github.com/cilium/cilium/pkg/hubble/metrics/api"
+	pkglabels "github.com/cilium/cilium/pkg/labels"
+)
+
+// causeTCPRST is the cause label value used for TCP RST segments. ICMP
+// error messages use their type and code string as the cause instead.
+const causeTCPRST = "tcp-rst"
+
+type connectionErrorsHandler struct {
+	connectionErrors *prometheus.CounterVec
+	context          *api.ContextOptions
+	AllowList        filters.FilterFuncs
+	DenyList         filters.FilterFuncs
+}
+
+func (h *connectionErrorsHandler) Init(registry *prometheus.Registry, options *api.MetricConfig) error {
+	c, err := api.ParseContextOptions(options.ContextOptionConfigs)
+	if err != nil {
+		return err
+	}
+	h.context = c
+	err = h.HandleConfigurationUpdate(options)
+	if err != nil {
+		return err
+	}
+
+	labels := []string{"family", "cause", "origin"}
+	labels = append(labels, h.context.GetLabelNames()...)
+
+	h.connectionErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: api.DefaultPrometheusNamespace,
+		Name:      "connection_errors_total",
+		Help:      "Number of connection errors (TCP RST segments and ICMP error messages)",
+	}, labels)
+
+	registry.MustRegister(h.connectionErrors)
+	return nil
+}
+
+func (h *connectionErrorsHandler) Status() string {
+	return h.context.Status()
+}
+
+func (h *connectionErrorsHandler) Context() *api.ContextOptions {
+	return h.context
+}
+
+func (h *connectionErrorsHandler) ListMetricVec() []*prometheus.MetricVec {
+	return []*prometheus.MetricVec{h.connectionErrors.MetricVec}
+}
+
+// errorOrigin classifies the hop that generated the error. The source of a
+// RST or ICMP error flow is the hop which emitted it: a local endpoint, a
+// known reserved identity such as a node or the outside world, or unknown.
+func errorOrigin(src *flowpb.Endpoint) string {
+	if src == nil {
+		return "unknown"
+	}
+	if src.PodName != "" || src.ID != 0 {
+		return "endpoint"
+	}
+	for _, label := range src.Labels {
+		if name, ok := strings.CutPrefix(label, pkglabels.LabelSourceReserved+":"); ok {
+			return name
+		}
+	}
+	return "unknown"
+}
+
+// connectionErrorCause returns the cause label value for the flow, or an
+// empty string if the flow does not signal a connection error.
+func connectionErrorCause(l4 *flowpb.Layer4) string {
+	switch {
+	case l4.GetTCP() != nil:
+		if flags := l4.GetTCP().GetFlags(); flags.GetRST() {
+			return causeTCPRST
+		}
+	case l4.GetICMPv4() != nil:
+		icmp := l4.GetICMPv4()
+		switch uint8(icmp.Type) {
+		case layers.ICMPv4TypeDestinationUnreachable,
+			layers.ICMPv4TypeTimeExceeded,
+			layers.ICMPv4TypeParameterProblem:
+			return layers.CreateICMPv4TypeCode(uint8(icmp.Type), uint8(icmp.Code)).String()
+		}
+	case l4.GetICMPv6() != nil:
+		icmp := l4.GetICMPv6()
+		switch uint8(icmp.Type) {
+		case layers.ICMPv6TypeDestinationUnreachable,
+			layers.ICMPv6TypePacketTooBig,
+			layers.ICMPv6TypeTimeExceeded,
+			layers.ICMPv6TypeParameterProblem:
+			return layers.CreateICMPv6TypeCode(uint8(icmp.Type), uint8(icmp.Code)).String()
+		}
+	}
+	return ""
+}
+
+func (h *connectionErrorsHandler) ProcessFlow(ctx context.Context, flow *flowpb.Flow) error {
+	// Only consider errors which were actually delivered. Dropped flows are
+	// already accounted for by the drop metrics.
+	if (flow.GetVerdict() != flowpb.Verdict_FORWARDED && flow.GetVerdict() != flowpb.Verdict_REDIRECTED) ||
+		flow.GetL4() == nil || flow.GetIP() == nil {
+		return nil
+	}
+
+	cause := connectionErrorCause(flow.GetL4())
+	if cause == "" {
+		return nil
+	}
+
+	if !filters.Apply(h.AllowList, h.DenyList, &v1.Event{Event: flow, Timestamp: &timestamppb.Timestamp{}}) {
+		return nil
+	}
+
+	contextLabels, err := h.context.GetLabelValues(flow)
+	if err != nil {
+		return err
+	}
+
+	labels := []string{flow.GetIP().IpVersion.String(), cause, errorOrigin(flow.GetSource())}
+	labels = append(labels, contextLabels...)
+	h.connectionErrors.WithLabelValues(labels...).Inc()
+	return nil
+}
+
+func (h *connectionErrorsHandler) Deinit(registry *prometheus.Registry) error {
+	var errs error
+	if !registry.Unregister(h.connectionErrors) {
+		errs = errors.Join(errs, fmt.Errorf("failed to unregister metric: %v,", "connection_errors_total"))
+	}
+	return errs
+}
+
+func (h *connectionErrorsHandler) HandleConfigurationUpdate(cfg *api.MetricConfig) error {
+	return h.SetFilters(cfg)
+}
+
+func (h *connectionErrorsHandler) SetFilters(cfg *api.MetricConfig) error {
+	var err error
+	h.AllowList, err = filters.BuildFilterList(context.Background(), cfg.IncludeFilters, filters.DefaultFilters(slog.Default()))
+	if err != nil {
+		return err
+	}
+	h.DenyList, err = filters.BuildFilterList(context.Background(), cfg.ExcludeFilters, filters.DefaultFilters(slog.Default()))
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package connection_errors
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+
+	flowpb "github.com/cilium/cilium/api/v1/flow"
+	"github.com/cilium/cilium/pkg/hubble/metrics/api"
+)
+
+func TestConnectionErrorsHandler_MatchingFlows(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	opts := &api.MetricConfig{
+		ContextOptionConfigs: []*api.ContextOptionConfig{
+			{
+				Name:   "destinationContext",
+				Values: []string{"namespace"},
+			},
+		},
+	}
+
+	h := &connectionErrorsHandler{}
+	assert.NoError(t, h.Init(registry, opts))
+	assert.NoError(t, testutil.CollectAndCompare(h.connectionErrors, strings.NewReader("")))
+
+	// RST reset by a server pod, delivered to a client in namespace "client-ns".
+	rst := &flowpb.Flow{
+		Verdict: flowpb.Verdict_FORWARDED,
+		IP:      &flowpb.IP{IpVersion: flowpb.IPVersion_IPv4},
+		L4: &flowpb.Layer4{
+			Protocol: &flowpb.Layer4_TCP{
+				TCP: &flowpb.TCP{Flags: &flowpb.TCPFlags{RST: true, ACK: true}},
+			},
+		},
+		Source:      &flowpb.Endpoint{ID: 42, Namespace: "server-ns", PodName: "server"},
+		Destination: &flowpb.Endpoint{Namespace: "client-ns"},
+	}
+	assert.NoError(t, h.ProcessFlow(context.Background(), rst))
+
+	// Host unreachable generated by an intermediate router outside the cluster.
+	icmp := &flowpb.Flow{
+		Verdict: flowpb.Verdict_FORWARDED,
+		IP:      &flowpb.IP{IpVersion: flowpb.IPVersion_IPv4},
+		L4: &flowpb.Layer4{
+			Protocol: &flowpb.Layer4_ICMPv4{
+				ICMPv4: &flowpb.ICMPv4{Type: 3, Code: 1},
+			},
+		},
+		Source:      &flowpb.Endpoint{Labels: []string{"reserved:world"}},
+		Destination: &flowpb.Endpoint{Namespace: "client-ns"},
+	}
+	assert.NoError(t, h.ProcessFlow(context.Background(), icmp))
+
+	expected := strings.NewReader(`# HELP hubble_connection_errors_total Number of connection errors (TCP RST segments and ICMP error messages)
+# TYPE hubble_connection_errors_total counter
+hubble_connection_errors_total{cause="tcp-rst",destination="client-ns",family="IPv4",origin="endpoint"} 1
+hubble_connection_errors_total{cause="DestinationUnreachable(Host)",destination="client-ns",family="IPv4",origin="world"} 1
+`)
+	assert.NoError(t, testutil.CollectAndCompare(h.connectionErrors, expected))
+}
+
+func TestConnectionErrorsHandler_NonMatchingFlows(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	h := &connectionErrorsHandler{}
+	assert.NoError(t, h.Init(registry, &api.MetricConfig{}))
+
+	// RST segments which were dropped are accounted for by the drop metrics.
+	assert.NoError(t, h.ProcessFlow(context.Background(), &flowpb.Flow{
+		Verdict: flowpb.Verdict_DROPPED,
+		IP:      &flowpb.IP{IpVersion: flowpb.IPVersion_IPv4},
+		L4: &flowpb.Layer4{
+			Protocol: &flowpb.Layer4_TCP{
+				TCP: &flowpb.TCP{Flags: &flowpb.TCPFlags{RST: true}},
+			},
+		},
+	}))
+	// TCP flows without RST are not connection errors.
+	assert.NoError(t, h.ProcessFlow(context.Background(), &flowpb.Flow{
+		Verdict: flowpb.Verdict_FORWARDED,
+		IP:      &flowpb.IP{IpVersion: flowpb.IPVersion_IPv4},
+		L4: &flowpb.Layer4{
+			Protocol: &flowpb.Layer4_TCP{
+				TCP: &flowpb.TCP{Flags: &flowpb.TCPFlags{SYN: true}},
+			},
+		},
+	}))
+	// ICMP informational messages are not connection errors.
+	assert.NoError(t, h.ProcessFlow(context.Background(), &flowpb.Flow{
+		Verdict: flowpb.Verdict_FORWARDED,
+		IP:      &flowpb.IP{IpVersion: flowpb.IPVersion_IPv4},
+		L4: &flowpb.Layer4{
+			Protocol: &flowpb.Layer4_ICMPv4{
+				ICMPv4: &flowpb.ICMPv4{Type: 8, Code: 0},
+			},
+		},
+	}))
+
+	assert.NoError(t, testutil.CollectAndCompare(h.connectionErrors, strings.NewReader("")))
+}
@@ -0,0 +1,38 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package connection_errors
+
+import (
+	"github.com/cilium/cilium/pkg/hubble/metrics/api"
+)
+
+type connectionErrorsPlugin struct{}
+
+func (p *connectionErrorsPlugin) NewHandler() api.Handler {
+	return &connectionErrorsHandler{}
+}
+
+func (p *connectionErrorsPlugin) HelpText() string {
+	return `connection-errors - Connection error metrics
+Reports metrics for forwarded flows that signal a connection failure:
+TCP RST segments and ICMP error messages such as destination unreachable
+or time exceeded. Unlike drop metrics, these errors are generated by the
+peer or by an intermediate hop, not by the datapath.
+
+Metrics:
+  hubble_connection_errors_total  Number of connection errors observed.
+
+Labels:
+  family - IP family of the flow ("IPv4" or "IPv6").
+  cause  - "tcp-rst" for TCP resets, or the ICMP type and code of the
+           error message.
+  origin - Where the error originated: "endpoint" for a local endpoint,
+           the reserved identity name (e.g. "world", "remote-node") for
+           known non-endpoint origins, or "unknown".` +
+		api.ContextOptionsHelp
+}
+
+func init() {
+	api.DefaultRegistry().Register("connection-errors", &connectionErrorsPlugin{})
+}
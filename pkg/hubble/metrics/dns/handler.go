@@ -28,9 +28,10 @@ type dnsHandler struct {
 	AllowList filters.FilterFuncs
 	DenyList  filters.FilterFuncs
 
-	queries       *prometheus.CounterVec
-	responses     *prometheus.CounterVec
-	responseTypes *prometheus.CounterVec
+	queries         *prometheus.CounterVec
+	responses       *prometheus.CounterVec
+	responseTypes   *prometheus.CounterVec
+	responseLatency *prometheus.HistogramVec
 }
 
 func (h *dnsHandler) Init(registry *prometheus.Registry, options *api.MetricConfig) error {
@@ -84,6 +85,14 @@ func (h *dnsHandler) Init(registry *prometheus.Registry, options *api.MetricConf
 	}, responseTypeLabels)
 	registry.MustRegister(h.responseTypes)
 
+	h.responseLatency = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: api.DefaultPrometheusNamespace,
+		Name:      "dns_response_latency_seconds",
+		Help:      "Latency between a DNS query and its response",
+		Buckets:   []float64{.0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5},
+	}, contextLabels)
+	registry.MustRegister(h.responseLatency)
+
 	return nil
 }
 
@@ -104,7 +113,7 @@ func (h *dnsHandler) Context() *api.ContextOptions {
 }
 
 func (h *dnsHandler) ListMetricVec() []*prometheus.MetricVec {
-	return []*prometheus.MetricVec{h.queries.MetricVec, h.responses.MetricVec, h.responseTypes.MetricVec}
+	return []*prometheus.MetricVec{h.queries.MetricVec, h.responses.MetricVec, h.responseTypes.MetricVec, h.responseLatency.MetricVec}
 }
 
 func (h *dnsHandler) ProcessFlow(ctx context.Context, flow *flowpb.Flow) error {
@@ -163,6 +172,10 @@ func (h *dnsHandler) ProcessFlow(ctx context.Context, flow *flowpb.Flow) error {
 			}
 			h.responseTypes.WithLabelValues(newLabels...).Inc()
 		}
+
+		if latency := flow.GetL7().GetLatencyNs(); latency > 0 {
+			h.responseLatency.WithLabelValues(contextLabels...).Observe(float64(latency) / 1e9)
+		}
 	}
 
 	return nil
@@ -179,6 +192,9 @@ func (h *dnsHandler) Deinit(registry *prometheus.Registry) error {
 	if !registry.Unregister(h.responseTypes) {
 		errs = errors.Join(errs, fmt.Errorf("failed to unregister metric: %v,", "dns_response_types_total"))
 	}
+	if !registry.Unregister(h.responseLatency) {
+		errs = errors.Join(errs, fmt.Errorf("failed to unregister metric: %v,", "dns_response_latency_seconds"))
+	}
 	return errs
 }
 
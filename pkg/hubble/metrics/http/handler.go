@@ -130,11 +130,11 @@ func (h *httpHandler) reporter(flow *flowpb.Flow) string {
 	return reporter
 }
 
-func (h *httpHandler) traceID(flow *flowpb.Flow) string {
+func (h *httpHandler) exemplar(flow *flowpb.Flow) prometheus.Labels {
 	if h.exemplars {
-		return flow.GetTraceContext().GetParent().GetTraceId()
+		return api.FlowExemplar(flow)
 	}
-	return ""
+	return nil
 }
 
 func (h *httpHandler) processMetricsV2(flow *flowpb.Flow) error {
@@ -142,7 +142,7 @@ func (h *httpHandler) processMetricsV2(flow *flowpb.Flow) error {
 		return nil
 	}
 	reporter := h.reporter(flow)
-	traceID := h.traceID(flow)
+	exemplar := h.exemplar(flow)
 
 	if !filters.Apply(h.AllowList, h.DenyList, &v1.Event{Event: flow, Timestamp: &timestamppb.Timestamp{}}) {
 		return nil
@@ -158,8 +158,8 @@ func (h *httpHandler) processMetricsV2(flow *flowpb.Flow) error {
 	requestsCounter := h.requests.WithLabelValues(append(labelValues, http.GetMethod(), http.GetProtocol(), status, reporter)...)
 	requestDurationHistogram := h.duration.WithLabelValues(append(labelValues, http.GetMethod(), reporter)...)
 
-	incrementCounter(requestsCounter, traceID)
-	observerObserve(requestDurationHistogram, float64(flow.GetL7().GetLatencyNs())/float64(time.Second), traceID)
+	api.CounterInc(requestsCounter, exemplar)
+	api.ObserverObserve(requestDurationHistogram, float64(flow.GetL7().GetLatencyNs())/float64(time.Second), exemplar)
 
 	return nil
 }
@@ -173,7 +173,7 @@ func (h *httpHandler) processMetricsV1(flow *flowpb.Flow) error {
 		return nil
 	}
 	reporter := h.reporter(flow)
-	traceID := h.traceID(flow)
+	exemplar := h.exemplar(flow)
 
 	if !filters.Apply(h.AllowList, h.DenyList, &v1.Event{Event: flow, Timestamp: &timestamppb.Timestamp{}}) {
 		return nil
@@ -189,33 +189,17 @@ func (h *httpHandler) processMetricsV1(flow *flowpb.Flow) error {
 	switch flow.GetL7().GetType() {
 	case flowpb.L7FlowType_REQUEST:
 		requestsCounter = h.requests.WithLabelValues(append(labelValues, http.GetMethod(), http.GetProtocol(), reporter)...)
-		incrementCounter(requestsCounter, traceID)
+		api.CounterInc(requestsCounter, exemplar)
 	case flowpb.L7FlowType_RESPONSE:
 		status := strconv.Itoa(int(http.GetCode()))
 		responsesCounter = h.responses.WithLabelValues(append(labelValues, http.GetMethod(), http.GetProtocol(), status, reporter)...)
 		requestDurationHistogram := h.duration.WithLabelValues(append(labelValues, http.GetMethod(), reporter)...)
-		incrementCounter(responsesCounter, traceID)
-		observerObserve(requestDurationHistogram, float64(flow.GetL7().GetLatencyNs())/float64(time.Second), traceID)
+		api.CounterInc(responsesCounter, exemplar)
+		api.ObserverObserve(requestDurationHistogram, float64(flow.GetL7().GetLatencyNs())/float64(time.Second), exemplar)
 	}
 	return nil
 }
 
-func incrementCounter(c prometheus.Counter, traceID string) {
-	if adder, ok := c.(prometheus.ExemplarAdder); ok && traceID != "" {
-		adder.AddWithExemplar(1, prometheus.Labels{"traceID": traceID})
-	} else {
-		c.Inc()
-	}
-}
-
-func observerObserve(o prometheus.Observer, value float64, traceID string) {
-	if adder, ok := o.(prometheus.ExemplarObserver); ok && traceID != "" {
-		adder.ObserveWithExemplar(value, prometheus.Labels{"traceID": traceID})
-	} else {
-		o.Observe(value)
-	}
-}
-
 func (h *httpHandler) Deinit(registry *prometheus.Registry) error {
 	var errs error
 
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"log/slog"
 	"strconv"
+	"strings"
 
 	"github.com/prometheus/client_golang/prometheus"
 
@@ -27,6 +28,7 @@ type kafkaHandler struct {
 	context   *api.ContextOptions
 	AllowList filters.FilterFuncs
 	DenyList  filters.FilterFuncs
+	exemplars bool
 }
 
 func (h *kafkaHandler) Init(registry *prometheus.Registry, options *api.MetricConfig) error {
@@ -40,6 +42,15 @@ func (h *kafkaHandler) Init(registry *prometheus.Registry, options *api.MetricCo
 		return err
 	}
 
+	for _, opt := range options.ContextOptionConfigs {
+		if strings.ToLower(opt.Name) == "exemplars" {
+			if len(opt.Values) >= 1 && opt.Values[0] == "true" {
+				h.exemplars = true
+			}
+			break
+		}
+	}
+
 	h.requests = prometheus.NewCounterVec(prometheus.CounterOpts{
 		Namespace: api.DefaultPrometheusNamespace,
 		Name:      "kafka_requests_total",
@@ -59,7 +70,7 @@ func (h *kafkaHandler) Status() string {
 	if h.context == nil {
 		return ""
 	}
-	return h.context.Status()
+	return h.context.Status() + fmt.Sprintf(",exemplars=%t", h.exemplars)
 }
 
 func (h *kafkaHandler) Context() *api.ContextOptions {
@@ -101,8 +112,13 @@ func (h *kafkaHandler) ProcessFlow(ctx context.Context, flow *flowpb.Flow) error
 		reporter = "server"
 	}
 
-	h.requests.WithLabelValues(append(labelValues, kafka.Topic, kafka.ApiKey, strconv.Itoa(int(kafka.ErrorCode)), reporter)...).Inc()
-	h.duration.WithLabelValues(append(labelValues, kafka.Topic, kafka.ApiKey, reporter)...).Observe(float64(l7.LatencyNs) / float64(time.Second))
+	var exemplar prometheus.Labels
+	if h.exemplars {
+		exemplar = api.FlowExemplar(flow)
+	}
+
+	api.CounterInc(h.requests.WithLabelValues(append(labelValues, kafka.Topic, kafka.ApiKey, strconv.Itoa(int(kafka.ErrorCode)), reporter)...), exemplar)
+	api.ObserverObserve(h.duration.WithLabelValues(append(labelValues, kafka.Topic, kafka.ApiKey, reporter)...), float64(l7.LatencyNs)/float64(time.Second), exemplar)
 	return nil
 }
 
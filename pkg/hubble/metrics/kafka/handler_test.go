@@ -34,7 +34,7 @@ func Test_kafkaHandler_Status(t *testing.T) {
 		},
 	}
 	require.NoError(t, handler.Init(prometheus.NewRegistry(), options))
-	assert.Equal(t, "destination=identity,source=namespace", handler.Status())
+	assert.Equal(t, "destination=identity,source=namespace,exemplars=false", handler.Status())
 }
 
 func Test_kafkaHandler_ProcessFlow(t *testing.T) {
@@ -89,3 +89,69 @@ func TestDecodeL7DNSRecord(t *testing.T) {
 		Rrtypes:           []string{"A"},
 	}, f.GetL7().GetDns())
 }
+
+func TestDecodeL7DNSLatency(t *testing.T) {
+	request := &accesslog.LogRecord{
+		Type:                accesslog.TypeRequest,
+		Timestamp:           "2006-01-02T15:04:05.000000000Z",
+		NodeAddressInfo:     fakeNodeInfo,
+		ObservationPoint:    accesslog.Ingress,
+		SourceEndpoint:      fakeSourceEndpoint,
+		DestinationEndpoint: fakeDestinationEndpoint,
+		IPVersion:           accesslog.VersionIPV6,
+		Verdict:             accesslog.VerdictForwarded,
+		TransportProtocol:   accesslog.TransportProtocol(u8proto.UDP),
+		DNS: &accesslog.LogRecordDNS{
+			Query:         "deathstar.empire.svc.cluster.local.",
+			TransactionID: 0xcafe,
+			QTypes:        []uint16{1},
+		},
+	}
+	request.SourceEndpoint.Port = 56789
+	request.DestinationEndpoint.Port = 53
+
+	response := &accesslog.LogRecord{
+		Type:                accesslog.TypeResponse,
+		Timestamp:           "2006-01-02T15:04:05.025000000Z",
+		NodeAddressInfo:     fakeNodeInfo,
+		ObservationPoint:    accesslog.Ingress,
+		SourceEndpoint:      fakeDestinationEndpoint,
+		DestinationEndpoint: fakeSourceEndpoint,
+		IPVersion:           accesslog.VersionIPV6,
+		Verdict:             accesslog.VerdictForwarded,
+		TransportProtocol:   accesslog.TransportProtocol(u8proto.UDP),
+		DNS: &accesslog.LogRecordDNS{
+			Query:         "deathstar.empire.svc.cluster.local.",
+			TransactionID: 0xcafe,
+			IPs:           []netip.Addr{netip.MustParseAddr("1.2.3.4")},
+			TTL:           5,
+			RCode:         0,
+			QTypes:        []uint16{1},
+			AnswerTypes:   []uint16{1},
+		},
+	}
+	response.SourceEndpoint.Port = 53
+	response.DestinationEndpoint.Port = 56789
+
+	parser, err := New(hivetest.Logger(t), &testutils.NoopDNSGetter, &testutils.NoopIPGetter,
+		&testutils.NoopServiceGetter, &testutils.NoopEndpointGetter)
+	require.NoError(t, err)
+
+	f := &flowpb.Flow{}
+	err = parser.Decode(request, f)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), f.GetL7().GetLatencyNs())
+
+	f = &flowpb.Flow{}
+	err = parser.Decode(response, f)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(25*time.Millisecond), f.GetL7().GetLatencyNs())
+
+	// A response with a different transaction ID does not correlate with the
+	// request.
+	response.DNS.TransactionID = 0xbeef
+	f = &flowpb.Flow{}
+	err = parser.Decode(response, f)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), f.GetL7().GetLatencyNs())
+}
@@ -168,9 +168,29 @@ func extractRequestID(r *accesslog.LogRecord) string {
 	if r.HTTP != nil {
 		requestID = r.HTTP.Headers.Get("X-Request-Id")
 	}
+	if r.DNS != nil {
+		requestID = dnsRequestID(r)
+	}
 	return requestID
 }
 
+// dnsRequestID synthesizes a request ID for a DNS record from the transaction
+// ID and the flow it was observed on, so that queries can be correlated with
+// their responses.
+func dnsRequestID(r *accesslog.LogRecord) string {
+	// Request and response records have the client and the DNS server
+	// swapped. Normalize so that both derive the same ID.
+	client, server := r.SourceEndpoint, r.DestinationEndpoint
+	if r.Type == accesslog.TypeResponse {
+		client, server = server, client
+	}
+	return fmt.Sprintf("dns:%d:%s%s:%d:%s%s:%d:%s",
+		r.DNS.TransactionID,
+		client.IPv4, client.IPv6, client.Port,
+		server.IPv4, server.IPv6, server.Port,
+		r.DNS.Query)
+}
+
 func (p *Parser) getTraceContext(r *accesslog.LogRecord) *flowpb.TraceContext {
 	requestID := extractRequestID(r)
 	switch r.Type {
@@ -6,6 +6,7 @@ package identitycachecell
 import (
 	"cmp"
 	"context"
+	"fmt"
 	"log/slog"
 	"maps"
 	"net"
@@ -108,7 +109,7 @@ var defaultConfig = config{
 	IdentityManagementMode: option.IdentityManagementModeAgent,
 }
 
-func newIdentityAllocator(params identityAllocatorParams) identityAllocatorOut {
+func newIdentityAllocator(params identityAllocatorParams) (identityAllocatorOut, error) {
 	// iao: updates SelectorCache and regenerates endpoints when
 	// identity allocation / deallocation has occurred.
 	iao := &identityAllocatorOwner{
@@ -126,6 +127,16 @@ func newIdentityAllocator(params identityAllocatorParams) identityAllocatorOut {
 			params.Config.IdentityManagementMode == option.IdentityManagementModeBoth,
 		)
 
+		// The operator-managed CID reconciler derives pod identity labels
+		// without knowledge of the labels of the node each pod runs on, so it
+		// would compute a different label set than the agent and cause
+		// identity churn.
+		if isOperatorManageCIDsEnabled && option.Config.EnablePodTopologyLabels {
+			return identityAllocatorOut{}, fmt.Errorf("--%s is not supported with --%s=%q, only with %q",
+				option.EnablePodTopologyLabels, option.IdentityManagementMode,
+				params.Config.IdentityManagementMode, option.IdentityManagementModeAgent)
+		}
+
 		allocatorConfig := cache.AllocatorConfig{
 			EnableOperatorManageCIDs: isOperatorManageCIDsEnabled,
 		}
@@ -157,7 +168,7 @@ func newIdentityAllocator(params identityAllocatorParams) identityAllocatorOut {
 		CacheIdentityAllocator: idAlloc,
 		RemoteIdentityWatcher:  idAlloc,
 		IdentityObservable:     idAlloc,
-	}
+	}, nil
 }
 
 // identityAllocatorOwner is used to break the circular dependency between
@@ -123,3 +123,23 @@ func TestGetClusterIDShift(t *testing.T) {
 		})
 	}
 }
+
+func TestGetAllocationIdentityRange(t *testing.T) {
+	shift := GetClusterIDShift()
+
+	// The local cluster keeps the room below the minimal numeric identity
+	// reserved for well-known identities.
+	require.Equal(t, MinimalNumericIdentity, GetMinimalAllocationIdentity(0))
+	require.Equal(t, NumericIdentity(1<<shift-1), GetMaximumAllocationIdentity(0))
+
+	// Remote clusters get a disjoint range each, keyed by the cluster ID
+	// embedded in the upper bits of the numeric identity.
+	for clusterID := uint32(1); clusterID <= cmtypes.ClusterIDMax; clusterID <<= 1 {
+		min, max := GetMinimalAllocationIdentity(clusterID), GetMaximumAllocationIdentity(clusterID)
+		require.Equal(t, NumericIdentity(clusterID<<shift), min)
+		require.Equal(t, NumericIdentity((clusterID+1)<<shift-1), max)
+		require.Equal(t, clusterID, min.ClusterID())
+		require.Equal(t, clusterID, max.ClusterID())
+		require.Greater(t, min, GetMaximumAllocationIdentity(clusterID-1))
+	}
+}
@@ -7,10 +7,13 @@ import (
 	"context"
 	"log/slog"
 
+	operatorMetrics "github.com/cilium/cilium/operator/metrics"
+	operatorOption "github.com/cilium/cilium/operator/option"
 	"github.com/cilium/cilium/pkg/ipam"
 	"github.com/cilium/cilium/pkg/ipam/allocator"
 	cilium_v2alpha1 "github.com/cilium/cilium/pkg/k8s/apis/cilium.io/v2alpha1"
 	"github.com/cilium/cilium/pkg/logging/logfields"
+	"github.com/cilium/cilium/pkg/metrics"
 )
 
 var subsysLogAttr = []any{logfields.LogSubsys, "ipam-allocator-multi-pool"}
@@ -24,6 +27,11 @@ type Allocator struct {
 func (a *Allocator) Init(ctx context.Context, logger *slog.Logger) error {
 	a.poolAlloc = NewPoolAllocator(logger)
 	a.logger = logger.With(subsysLogAttr...)
+
+	if operatorOption.Config.EnableMetrics {
+		operatorMetrics.Registry.MustRegister(newPoolCollector(metrics.Namespace, a.poolAlloc))
+	}
+
 	return nil
 }
 
@@ -0,0 +1,109 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package multipool
+
+import (
+	"math/big"
+	"net/netip"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/cilium/cilium/pkg/ipam"
+)
+
+// poolCollector exposes per-pool address capacity and usage of the multi-pool
+// IPAM allocator. The values are computed on scrape from the allocator state,
+// allowing exhaustion of a pool to be forecast from the resulting time series
+// (e.g. via predict_linear in Prometheus).
+type poolCollector struct {
+	poolAlloc *PoolAllocator
+
+	capacityDesc *prometheus.Desc
+	usedDesc     *prometheus.Desc
+}
+
+func newPoolCollector(namespace string, poolAlloc *PoolAllocator) *poolCollector {
+	return &poolCollector{
+		poolAlloc: poolAlloc,
+		capacityDesc: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "ipam", "pool_capacity_addresses"),
+			"Total number of addresses allocatable from a multi-pool IPAM pool",
+			[]string{"pool", "family"}, nil,
+		),
+		usedDesc: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "ipam", "pool_used_addresses"),
+			"Number of addresses of a multi-pool IPAM pool allocated to nodes",
+			[]string{"pool", "family"}, nil,
+		),
+	}
+}
+
+func (c *poolCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.capacityDesc
+	ch <- c.usedDesc
+}
+
+func (c *poolCollector) Collect(ch chan<- prometheus.Metric) {
+	for _, s := range c.poolAlloc.poolStats() {
+		ch <- prometheus.MustNewConstMetric(c.capacityDesc, prometheus.GaugeValue,
+			bigIntToFloat64(s.capacity), s.pool, string(s.family))
+		ch <- prometheus.MustNewConstMetric(c.usedDesc, prometheus.GaugeValue,
+			bigIntToFloat64(s.used), s.pool, string(s.family))
+	}
+}
+
+func bigIntToFloat64(i *big.Int) float64 {
+	f, _ := new(big.Float).SetInt(i).Float64()
+	return f
+}
+
+// poolStat describes the address capacity and usage of a single pool for one
+// address family.
+type poolStat struct {
+	pool     string
+	family   ipam.Family
+	capacity *big.Int
+	used     *big.Int
+}
+
+// poolStats returns the address capacity and usage of all pools, per address
+// family.
+func (p *PoolAllocator) poolStats() []poolStat {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+
+	stats := make([]poolStat, 0, 2*len(p.pools))
+	for name, pool := range p.pools {
+		v4 := poolStat{pool: name, family: ipam.IPv4, capacity: big.NewInt(0), used: big.NewInt(0)}
+		v6 := poolStat{pool: name, family: ipam.IPv6, capacity: big.NewInt(0), used: big.NewInt(0)}
+
+		for _, cidrSet := range pool.v4 {
+			v4.capacity.Add(v4.capacity, allocatableAddrsInPool(cidrSet.Prefix(), pool.v4MaskSize))
+		}
+		for _, cidrSet := range pool.v6 {
+			v6.capacity.Add(v6.capacity, allocatableAddrsInPool(cidrSet.Prefix(), pool.v6MaskSize))
+		}
+
+		for _, pools := range p.nodes {
+			v4.used.Add(v4.used, pools[name].v4.availableAddrs())
+			v6.used.Add(v6.used, pools[name].v6.availableAddrs())
+		}
+
+		stats = append(stats, v4, v6)
+	}
+
+	return stats
+}
+
+// allocatableAddrsInPool returns the number of addresses usable for pod IPAM
+// in the pool prefix p when carved into maskSize-sized per-node CIDRs.
+func allocatableAddrsInPool(p netip.Prefix, maskSize int) *big.Int {
+	if !p.IsValid() || maskSize < p.Bits() || maskSize > p.Addr().BitLen() {
+		return big.NewInt(0)
+	}
+
+	perCIDR := addrsInPrefix(netip.PrefixFrom(p.Addr(), maskSize))
+	count := new(big.Int).Lsh(big.NewInt(1), uint(maskSize-p.Bits()))
+	return count.Mul(count, perCIDR)
+}
@@ -500,3 +500,44 @@ func Test_addrsInPrefix(t *testing.T) {
 		})
 	}
 }
+
+func TestPoolStats(t *testing.T) {
+	p := NewPoolAllocator(hivetest.Logger(t))
+	err := p.UpsertPool("default",
+		[]string{"10.100.0.0/16"}, 24,
+		[]string{"fd00:100::/80"}, 96,
+	)
+	assert.NoError(t, err)
+
+	statsByFamily := func() map[string]poolStat {
+		stats := map[string]poolStat{}
+		for _, s := range p.poolStats() {
+			stats[string(s.family)] = s
+		}
+		return stats
+	}
+
+	// 256 /24 CIDRs with 254 usable addresses each
+	v4Capacity := big.NewInt(256 * 254)
+	// 65536 /96 CIDRs with 2^32-2 usable addresses each
+	v6Capacity := new(big.Int).Mul(big.NewInt(65536), big.NewInt(4294967294))
+
+	stats := statsByFamily()
+	assert.Len(t, stats, 2)
+	assert.Zero(t, stats["ipv4"].capacity.Cmp(v4Capacity))
+	assert.Zero(t, stats["ipv4"].used.Cmp(big.NewInt(0)))
+	assert.Zero(t, stats["ipv6"].capacity.Cmp(v6Capacity))
+	assert.Zero(t, stats["ipv6"].used.Cmp(big.NewInt(0)))
+
+	err = p.occupyCIDR("node1", "default", netip.MustParsePrefix("10.100.10.0/24"))
+	assert.NoError(t, err)
+	err = p.occupyCIDR("node2", "default", netip.MustParsePrefix("10.100.20.0/24"))
+	assert.NoError(t, err)
+	err = p.occupyCIDR("node1", "default", netip.MustParsePrefix("fd00:100:0:0:0:10::/96"))
+	assert.NoError(t, err)
+
+	stats = statsByFamily()
+	assert.Zero(t, stats["ipv4"].capacity.Cmp(v4Capacity))
+	assert.Zero(t, stats["ipv4"].used.Cmp(big.NewInt(2*254)))
+	assert.Zero(t, stats["ipv6"].used.Cmp(big.NewInt(4294967294)))
+}
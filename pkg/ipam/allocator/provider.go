@@ -5,10 +5,12 @@ package allocator
 
 import (
 	"context"
+	"fmt"
 	"log/slog"
 
 	"github.com/cilium/cilium/pkg/ipam"
 	v2 "github.com/cilium/cilium/pkg/k8s/apis/cilium.io/v2"
+	"github.com/cilium/cilium/pkg/lock"
 	"github.com/cilium/cilium/pkg/time"
 )
 
@@ -25,3 +27,31 @@ type NodeEventHandler interface {
 	Delete(resource *v2.CiliumNode)
 	Resync(context.Context, time.Time)
 }
+
+var (
+	providersMutex lock.RWMutex
+	providers      = make(map[string]AllocatorProvider)
+)
+
+// Register makes the given provider available under the given IPAM mode. It
+// is intended to be called from an init() function, both by the built-in
+// providers and by out-of-tree providers compiled into the operator. It
+// panics if a provider is already registered for the mode.
+func Register(name string, provider AllocatorProvider) {
+	providersMutex.Lock()
+	defer providersMutex.Unlock()
+
+	if _, ok := providers[name]; ok {
+		panic(fmt.Sprintf("IPAM allocator provider already registered for mode %q", name))
+	}
+	providers[name] = provider
+}
+
+// GetProvider returns the provider registered for the given IPAM mode, if any.
+func GetProvider(name string) (AllocatorProvider, bool) {
+	providersMutex.RLock()
+	defer providersMutex.RUnlock()
+
+	provider, ok := providers[name]
+	return provider, ok
+}
@@ -61,6 +61,23 @@ func (e *ErrPoolNotReadyYet) Is(err error) bool {
 	return ok
 }
 
+// ErrIPNotInPoolRange is returned when a specific IP cannot be reserved
+// because it is outside the range of all pod CIDRs of the target pool. This
+// typically happens for endpoints restored after the agent was migrated from
+// another IPAM mode, e.g. from cluster-pool to multi-pool.
+type ErrIPNotInPoolRange struct {
+	ip net.IP
+}
+
+func (e *ErrIPNotInPoolRange) Error() string {
+	return fmt.Sprintf("IP %s not in range of any pod CIDR", e.ip)
+}
+
+func (e *ErrIPNotInPoolRange) Is(err error) bool {
+	_, ok := err.(*ErrIPNotInPoolRange)
+	return ok
+}
+
 var multiPoolControllerGroup = controller.NewGroup(multiPoolControllerName)
 
 type poolPair struct {
@@ -131,6 +131,12 @@ func Test_MultiPoolManager(t *testing.T) {
 	assert.ErrorIs(t, err, ipallocator.ErrAllocated)
 	assert.Nil(t, faultyAllocation)
 
+	// cannot allocate an IP outside of all pod CIDRs of the pool, e.g. one
+	// restored after a migration from a different IPAM mode
+	faultyAllocation, err = c.allocateIP(net.ParseIP("10.255.0.1"), "restored-pod-0", "default", IPv4, false)
+	assert.ErrorIs(t, err, &ErrIPNotInPoolRange{})
+	assert.Nil(t, faultyAllocation)
+
 	// Allocation from an unknown pool should create a new pending allocation
 	jupiterIPv4CIDR := cidr.MustParseCIDR("192.168.1.0/16")
 	juptierIPv6CIDR := cidr.MustParseCIDR("fc00:33::/96")
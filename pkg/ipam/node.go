@@ -692,6 +692,12 @@ func (n *Node) determineMaintenanceAction() (*maintenanceAction, error) {
 		}
 	} else if numPendingPods > stats.IPv4.NeededIPs {
 		surgeAllocate = numPendingPods - stats.IPv4.NeededIPs
+		// Cap surge allocation so that a large burst of pending pods
+		// does not exhaust the cloud provider API or the address space
+		// of the node in one go.
+		if limit := operatorOption.Config.PendingPodSurgeLimit; limit > 0 && surgeAllocate > limit {
+			surgeAllocate = limit
+		}
 	}
 
 	n.mutex.RLock()
@@ -61,7 +61,7 @@ func (p *podCIDRPool) allocate(ip net.IP) error {
 		}
 	}
 
-	return fmt.Errorf("IP %s not in range of any pod CIDR", ip)
+	return &ErrIPNotInPoolRange{ip: ip}
 }
 
 func (p *podCIDRPool) allocateNext() (net.IP, error) {
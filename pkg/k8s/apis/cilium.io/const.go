@@ -30,6 +30,11 @@ const (
 	// running in
 	PolicyLabelCluster = LabelPrefix + ".policy.cluster"
 
+	// SecurityLabelHostNetwork is the name of the label associated with an
+	// endpoint to represent that the pod runs in the host network namespace.
+	// It is only derived when EnablePodSecurityLabels is set.
+	SecurityLabelHostNetwork = LabelPrefix + ".security.host-network"
+
 	// PodNamespaceMetaLabels is the label used to store the labels of the
 	// kubernetes namespace's labels.
 	PodNamespaceMetaLabels = LabelPrefix + ".namespace.labels"
@@ -41,6 +46,14 @@ const (
 	// to namespaces.
 	PodNamespaceMetaNameLabel = PodNamespaceMetaLabelsPrefix + LabelMetadataName
 
+	// PodNodeMetaLabels is the label used to store the labels of the
+	// kubernetes node the pod is running on. It is only derived when
+	// EnablePodTopologyLabels is set.
+	PodNodeMetaLabels = LabelPrefix + ".node.labels"
+
+	// PodNodeMetaLabelsPrefix is the prefix used for kubernetes node's labels
+	PodNodeMetaLabelsPrefix = PodNodeMetaLabels + "."
+
 	// LabelMetadataName is the label name which, in-tree, is used to
 	// automatically label namespaces, so they can be selected easily by tools
 	// which require definitive labels.
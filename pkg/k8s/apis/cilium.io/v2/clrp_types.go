@@ -103,6 +103,13 @@ type PortInfo struct {
 	// +kubebuilder:validation:Pattern=`^([0-9]{1,4})|([a-zA-Z0-9]-?)*[a-zA-Z](-?[a-zA-Z0-9])*$`
 	// +kubebuilder:validation:Optional
 	Name string `json:"name"`
+
+	// SkipRedirectFromBackend, if set, overrides the policy-wide
+	// SkipRedirectFromBackend flag for traffic matching this port.
+	// It is only honored for RedirectFrontend ports.
+	//
+	// +kubebuilder:validation:Optional
+	SkipRedirectFromBackend *bool `json:"skipRedirectFromBackend,omitempty"`
 }
 
 type ServiceInfo struct {
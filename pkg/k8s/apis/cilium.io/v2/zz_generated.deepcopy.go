@@ -2224,7 +2224,9 @@ func (in *Frontend) DeepCopyInto(out *Frontend) {
 	if in.ToPorts != nil {
 		in, out := &in.ToPorts, &out.ToPorts
 		*out = make([]PortInfo, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	return
 }
@@ -2378,6 +2380,11 @@ func (in *PeerConfigReference) DeepCopy() *PeerConfigReference {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PortInfo) DeepCopyInto(out *PortInfo) {
 	*out = *in
+	if in.SkipRedirectFromBackend != nil {
+		in, out := &in.SkipRedirectFromBackend, &out.SkipRedirectFromBackend
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -2398,7 +2405,9 @@ func (in *RedirectBackend) DeepCopyInto(out *RedirectBackend) {
 	if in.ToPorts != nil {
 		in, out := &in.ToPorts, &out.ToPorts
 		*out = make([]PortInfo, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	return
 }
@@ -2466,7 +2475,9 @@ func (in *ServiceInfo) DeepCopyInto(out *ServiceInfo) {
 	if in.ToPorts != nil {
 		in, out := &in.ToPorts, &out.ToPorts
 		*out = make([]PortInfo, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	return
 }
@@ -2185,6 +2185,14 @@ func (in *PortInfo) DeepEqual(other *PortInfo) bool {
 		return false
 	}
 
+	if (in.SkipRedirectFromBackend == nil) != (other.SkipRedirectFromBackend == nil) {
+		return false
+	} else if in.SkipRedirectFromBackend != nil {
+		if *in.SkipRedirectFromBackend != *other.SkipRedirectFromBackend {
+			return false
+		}
+	}
+
 	return true
 }
 
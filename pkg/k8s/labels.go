@@ -6,6 +6,9 @@ package k8s
 import (
 	"log/slog"
 
+	corev1 "k8s.io/api/core/v1"
+
+	k8sconst "github.com/cilium/cilium/pkg/k8s/apis/cilium.io"
 	slim_corev1 "github.com/cilium/cilium/pkg/k8s/slim/k8s/api/core/v1"
 	k8sUtils "github.com/cilium/cilium/pkg/k8s/utils"
 	"github.com/cilium/cilium/pkg/logging/logfields"
@@ -18,8 +21,9 @@ import (
 const UseOriginalSourceAddressLabel = "cilium.io/use-original-source-address"
 
 // GetPodMetadata returns the labels and annotations of the pod with the given
-// namespace / name.
-func GetPodMetadata(logger *slog.Logger, k8sNs *slim_corev1.Namespace, pod *slim_corev1.Pod) (containerPorts []slim_corev1.ContainerPort, lbls map[string]string) {
+// namespace / name. nodeLabels are the labels of the node the pod is running
+// on, and may be nil if EnablePodTopologyLabels is not set.
+func GetPodMetadata(logger *slog.Logger, k8sNs *slim_corev1.Namespace, pod *slim_corev1.Pod, nodeLabels map[string]string) (containerPorts []slim_corev1.ContainerPort, lbls map[string]string) {
 	namespace := pod.Namespace
 	logger.Debug(
 		"Connecting to k8s local stores to retrieve labels for pod",
@@ -30,6 +34,24 @@ func GetPodMetadata(logger *slog.Logger, k8sNs *slim_corev1.Namespace, pod *slim
 	objMetaCpy := pod.ObjectMeta.DeepCopy()
 	labels := k8sUtils.SanitizePodLabels(objMetaCpy.Labels, k8sNs, pod.Spec.ServiceAccountName, option.Config.ClusterName)
 
+	// Derive well-known security labels from the pod specification, so that
+	// they can be matched by policy selectors. User-provided labels with the
+	// same keys have already been stripped by SanitizePodLabels.
+	if option.Config.EnablePodSecurityLabels && pod.Spec.HostNetwork {
+		labels[k8sconst.SecurityLabelHostNetwork] = "true"
+	}
+
+	// Derive the topology labels of the node the pod is running on, so that
+	// policies can select peers by zone or region. User-provided labels with
+	// the same keys have already been stripped by SanitizePodLabels.
+	if option.Config.EnablePodTopologyLabels {
+		for _, key := range []string{corev1.LabelTopologyZone, corev1.LabelTopologyRegion} {
+			if value, ok := nodeLabels[key]; ok {
+				labels[k8sconst.PodNodeMetaLabelsPrefix+key] = value
+			}
+		}
+	}
+
 	for _, containers := range pod.Spec.Containers {
 		containerPorts = append(containerPorts, containers.Ports...)
 	}
@@ -4,6 +4,7 @@
 package k8s
 
 import (
+	"maps"
 	"testing"
 
 	"github.com/cilium/hive/hivetest"
@@ -11,6 +12,7 @@ import (
 
 	slim_corev1 "github.com/cilium/cilium/pkg/k8s/slim/k8s/api/core/v1"
 	slim_metav1 "github.com/cilium/cilium/pkg/k8s/slim/k8s/apis/meta/v1"
+	"github.com/cilium/cilium/pkg/option"
 )
 
 func TestGetPodMetadata(t *testing.T) {
@@ -46,7 +48,7 @@ func TestGetPodMetadata(t *testing.T) {
 	t.Run("normal scenario", func(t *testing.T) {
 		pod := pod.DeepCopy()
 
-		_, labels := GetPodMetadata(hivetest.Logger(t), ns, pod)
+		_, labels := GetPodMetadata(hivetest.Logger(t), ns, pod, nil)
 		require.Equal(t, expectedLabels, labels)
 	})
 
@@ -55,7 +57,7 @@ func TestGetPodMetadata(t *testing.T) {
 			pod := pod.DeepCopy()
 			pod.Labels["io.cilium.k8s.namespace.labels.namespace-level-key"] = "override-namespace-level-value"
 
-			_, labels := GetPodMetadata(hivetest.Logger(t), ns, pod)
+			_, labels := GetPodMetadata(hivetest.Logger(t), ns, pod, nil)
 			require.Equal(t, expectedLabels, labels)
 		})
 
@@ -63,7 +65,60 @@ func TestGetPodMetadata(t *testing.T) {
 			pod := pod.DeepCopy()
 			pod.Labels["io.cilium.k8s.namespace.labels.another-namespace-key"] = "another-namespace-level-value"
 
-			_, labels := GetPodMetadata(hivetest.Logger(t), ns, pod)
+			_, labels := GetPodMetadata(hivetest.Logger(t), ns, pod, nil)
+			require.Equal(t, expectedLabels, labels)
+		})
+	})
+
+	t.Run("security labels", func(t *testing.T) {
+		option.Config.EnablePodSecurityLabels = true
+		t.Cleanup(func() { option.Config.EnablePodSecurityLabels = false })
+
+		pod := pod.DeepCopy()
+		pod.Spec.HostNetwork = true
+
+		expectedLabels := maps.Clone(expectedLabels)
+		expectedLabels["io.cilium.k8s.security.host-network"] = "true"
+
+		_, labels := GetPodMetadata(hivetest.Logger(t), ns, pod, nil)
+		require.Equal(t, expectedLabels, labels)
+
+		t.Run("cannot be spoofed via pod labels", func(t *testing.T) {
+			pod := pod.DeepCopy()
+			pod.Spec.HostNetwork = false
+			pod.Labels["io.cilium.k8s.security.host-network"] = "true"
+
+			expectedLabels := maps.Clone(expectedLabels)
+			delete(expectedLabels, "io.cilium.k8s.security.host-network")
+
+			_, labels := GetPodMetadata(hivetest.Logger(t), ns, pod, nil)
+			require.Equal(t, expectedLabels, labels)
+		})
+	})
+
+	t.Run("topology labels", func(t *testing.T) {
+		option.Config.EnablePodTopologyLabels = true
+		t.Cleanup(func() { option.Config.EnablePodTopologyLabels = false })
+
+		pod := pod.DeepCopy()
+		nodeLabels := map[string]string{
+			"topology.kubernetes.io/zone":   "us-east-1a",
+			"topology.kubernetes.io/region": "us-east-1",
+			"kubernetes.io/hostname":        "node-1",
+		}
+
+		expectedLabels := maps.Clone(expectedLabels)
+		expectedLabels["io.cilium.k8s.node.labels.topology.kubernetes.io/zone"] = "us-east-1a"
+		expectedLabels["io.cilium.k8s.node.labels.topology.kubernetes.io/region"] = "us-east-1"
+
+		_, labels := GetPodMetadata(hivetest.Logger(t), ns, pod, nodeLabels)
+		require.Equal(t, expectedLabels, labels)
+
+		t.Run("cannot be spoofed via pod labels", func(t *testing.T) {
+			pod := pod.DeepCopy()
+			pod.Labels["io.cilium.k8s.node.labels.topology.kubernetes.io/zone"] = "us-west-2b"
+
+			_, labels := GetPodMetadata(hivetest.Logger(t), ns, pod, nodeLabels)
 			require.Equal(t, expectedLabels, labels)
 		})
 	})
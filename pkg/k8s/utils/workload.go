@@ -63,6 +63,14 @@ func GetWorkloadMetaFromPod(pod *slim_corev1.Pod) (slim_metav1.ObjectMeta, slim_
 				name := strings.TrimSuffix(controllerRef.Name, "-"+pod.Labels["pod-template-hash"])
 				workloadObjectMeta.Name = name
 				typeMetadata.Kind = "Deployment"
+			} else if typeMetadata.Kind == "ReplicaSet" && pod.Labels["rollouts-pod-template-hash"] != "" && strings.HasSuffix(controllerRef.Name, pod.Labels["rollouts-pod-template-hash"]) {
+				// Heuristic for Argo Rollout detection. Pods managed by a
+				// Rollout carry the rollouts-pod-template-hash label instead
+				// of pod-template-hash, and the owning ReplicaSet is named
+				// after the Rollout with the same hash as suffix.
+				name := strings.TrimSuffix(controllerRef.Name, "-"+pod.Labels["rollouts-pod-template-hash"])
+				workloadObjectMeta.Name = name
+				typeMetadata.Kind = "Rollout"
 			} else if typeMetadata.Kind == "ReplicaSet" && pod.Labels["pod-template-hash"] == "" {
 				workloadObjectMeta.Name = controllerRef.Name
 				typeMetadata.Kind = "ReplicaSet"
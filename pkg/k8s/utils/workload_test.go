@@ -128,6 +128,82 @@ func podForDeployment(deploymentName string, hash string) *slim_corev1.Pod {
 	}
 }
 
+func TestRolloutMetadata(t *testing.T) {
+	tests := []struct {
+		name               string
+		pod                *slim_corev1.Pod
+		wantTypeMetadata   slim_metav1.TypeMeta
+		wantObjectMetadata slim_metav1.ObjectMeta
+		expectOK           bool
+	}{
+		{
+			name: "rollout-name-rollout",
+			pod:  podForRollout("rollout", "7b4fd6c9d5"),
+			wantTypeMetadata: slim_metav1.TypeMeta{
+				Kind: "Rollout",
+			},
+			wantObjectMetadata: slim_metav1.ObjectMeta{
+				Name:         "rollout",
+				GenerateName: "rollout-7b4fd6c9d5-",
+				Labels: map[string]string{
+					"rollouts-pod-template-hash": "7b4fd6c9d5",
+				},
+			},
+			expectOK: true,
+		},
+		{
+			name: "rollout-name-rollout2",
+			pod:  podForRollout("rollout2", "6c48f7d8b9"),
+			wantTypeMetadata: slim_metav1.TypeMeta{
+				Kind: "Rollout",
+			},
+			wantObjectMetadata: slim_metav1.ObjectMeta{
+				Name:         "rollout2",
+				GenerateName: "rollout2-6c48f7d8b9-",
+				Labels: map[string]string{
+					"rollouts-pod-template-hash": "6c48f7d8b9",
+				},
+			},
+			expectOK: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotObjectMeta, gotTypeMeta, ok := GetWorkloadMetaFromPod(tt.pod)
+			if tt.expectOK != ok {
+				t.Fatalf("expected ok=%t, got ok=%t", tt.expectOK, ok)
+			}
+			if ok {
+				if !reflect.DeepEqual(gotObjectMeta, tt.wantObjectMetadata) {
+					t.Errorf("Object metadata got %+v want %+v", gotObjectMeta, tt.wantObjectMetadata)
+				}
+				if !reflect.DeepEqual(gotTypeMeta, tt.wantTypeMetadata) {
+					t.Errorf("Type metadata got %+v want %+v", gotTypeMeta, tt.wantTypeMetadata)
+				}
+			}
+		})
+	}
+}
+
+func podForRollout(rolloutName string, hash string) *slim_corev1.Pod {
+	controller := true
+	return &slim_corev1.Pod{
+		ObjectMeta: slim_metav1.ObjectMeta{
+			Name:         rolloutName + "-" + hash + "-" + "asdf0",
+			GenerateName: rolloutName + "-" + hash + "-",
+			OwnerReferences: []slim_metav1.OwnerReference{{
+				Controller: &controller,
+				Kind:       "ReplicaSet",
+				Name:       rolloutName + "-" + hash,
+			}},
+			Labels: map[string]string{
+				"rollouts-pod-template-hash": hash,
+			},
+		},
+	}
+}
+
 func TestCronJobMetadata(t *testing.T) {
 	tests := []struct {
 		name               string
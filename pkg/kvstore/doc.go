@@ -3,4 +3,25 @@
 
 // Package kvstore abstracts KVstore access and provides a high level API to
 // atomically manage cluster wide resources
+//
+// Backends register themselves via registerBackend() and are selected by name
+// at client setup time. A backend must implement the backendModule and
+// BackendOperations interfaces and uphold the following guarantees, which the
+// rest of the agent relies on:
+//
+//   - CreateOnly and the *IfLocked variants are atomic with respect to
+//     concurrent writers, including writers on other nodes.
+//   - Keys written with lease=true are automatically removed when the client
+//     that wrote them goes away, and lease expiration is reported through
+//     RegisterLeaseExpiredObserver. Identity and node liveness (heartbeat)
+//     handling depend on this.
+//   - ListAndWatch first emits the existing keys matching the prefix as
+//     create events, followed by a ListDone event, and then streams
+//     subsequent modifications in the order they were applied.
+//   - LockPath provides mutual exclusion across all clients of the store,
+//     not only within the local process.
+//
+// The integration tests in base_test.go exercise this contract and are run
+// against every backend wired into SetupDummyWithConfigOpts; a new backend
+// should be added there to validate its conformance.
 package kvstore
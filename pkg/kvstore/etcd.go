@@ -24,6 +24,8 @@ import (
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 	"golang.org/x/time/rate"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/encoding/gzip"
 	"sigs.k8s.io/yaml"
 
 	"github.com/cilium/cilium/api/v1/models"
@@ -62,6 +64,11 @@ const (
 	// by ListAndWatch operations. A 0 value equals to no limit.
 	EtcdListLimitOption = "etcd.limit"
 
+	// EtcdOptionCompression enables compression of the payloads exchanged
+	// with etcd, to reduce the bandwidth requirements in large clusters.
+	// Accepted values: "none", "gzip".
+	EtcdOptionCompression = "etcd.compression"
+
 	// etcdMaxKeysPerLease is the maximum number of keys that can be attached to a lease
 	etcdMaxKeysPerLease = 1000
 )
@@ -148,6 +155,17 @@ func newEtcdModule() backendModule {
 					return err
 				},
 			},
+			EtcdOptionCompression: &backendOption{
+				description: "Compression algorithm for the payloads exchanged with etcd (none, gzip)",
+				validate: func(v string) error {
+					switch v {
+					case "", "none", gzip.Name:
+						return nil
+					default:
+						return fmt.Errorf("unsupported compression algorithm %q", v)
+					}
+				},
+			},
 		},
 	}
 }
@@ -189,6 +207,7 @@ type clientOptions struct {
 	BootstrapRateLimit int
 	MaxInflight        int
 	ListBatchSize      int
+	Compression        string
 }
 
 func (e *etcdModule) newClient(ctx context.Context, logger *slog.Logger, opts *ExtraOptions) (BackendOperations, chan error) {
@@ -229,6 +248,10 @@ func (e *etcdModule) newClient(ctx context.Context, logger *slog.Logger, opts *E
 		clientOptions.KeepAliveHeartbeat, _ = time.ParseDuration(o.value)
 	}
 
+	if o, ok := e.opts[EtcdOptionCompression]; ok && o.value != "" && o.value != "none" {
+		clientOptions.Compression = o.value
+	}
+
 	endpointsOpt, endpointsSet := e.opts[EtcdAddrOption]
 	configPathOpt, configSet := e.opts[EtcdOptionConfig]
 
@@ -526,6 +549,13 @@ func connectEtcdClient(ctx context.Context, logger *slog.Logger, config *client.
 	// Use the shared etcd client logger to prevent unnecessary allocations.
 	config.Logger = etcd3ClientLogger
 
+	// Enable payload compression if requested. The server transparently
+	// handles compressed requests regardless of its own configuration.
+	if clientOptions.Compression != "" {
+		config.DialOptions = append(config.DialOptions,
+			grpc.WithDefaultCallOptions(grpc.UseCompressor(clientOptions.Compression)))
+	}
+
 	c, err := client.New(*config)
 	if err != nil {
 		return nil, err
@@ -1110,6 +1140,8 @@ func (e *etcdClient) statusChecker() {
 
 		allConnected := len(endpoints) == ok
 
+		recordLeaseCount(e.leaseManager.TotalLeases(), e.lockLeaseManager.TotalLeases())
+
 		quorumString := "true"
 		if quorumError != nil {
 			quorumString = quorumError.Error()
@@ -172,6 +172,10 @@ func etcdDbgEndpoint(ctx context.Context, ep string, tlscfg *tls.Config, dialer
 	err = tconn.HandshakeContext(ctx)
 	if err != nil {
 		iw.Println("❌ Cannot establish TLS connection to %s: %s", u.Host, err)
+		var hostnameErr x509.HostnameError
+		if errors.As(err, &hostnameErr) {
+			iw.Println("ℹ️  The server certificate SANs do not include %q: regenerate the certificate or configure the expected server name", tlscfg.ServerName)
+		}
 		if len(acceptableCAs) > 0 {
 			// The output is suboptimal being DER-encoded, but there doesn't
 			// seem to be any easy way to parse it (the utility used by
@@ -383,6 +387,26 @@ func etcdDbgOutputCert(cert *x509.Certificate, iw *indentedWriter) {
 	iw.Println("  Validity:")
 	iw.Println("    Not before:  %s", cert.NotBefore)
 	iw.Println("    Not after:   %s", cert.NotAfter)
+
+	now := time.Now()
+	switch {
+	case now.Before(cert.NotBefore):
+		iw.Println("  ❌ Certificate is not yet valid: check for clock skew")
+	case now.After(cert.NotAfter):
+		iw.Println("  ❌ Certificate has expired: rotate it and restart the agent")
+	case now.Add(30 * 24 * time.Hour).After(cert.NotAfter):
+		iw.Println("  ⚠️ Certificate expires in less than 30 days")
+	}
+
+	if len(cert.DNSNames) > 0 || len(cert.IPAddresses) > 0 {
+		iw.Println("  Subject Alternative Names:")
+		if len(cert.DNSNames) > 0 {
+			iw.Println("    DNS: %s", strings.Join(cert.DNSNames, ", "))
+		}
+		if len(cert.IPAddresses) > 0 {
+			iw.Println("    IP:  %s", etcdDbgOutputIPs(cert.IPAddresses))
+		}
+	}
 }
 
 type indentedWriter struct {
@@ -45,6 +45,14 @@ func trackEventQueued(scope string, typ EventType, duration time.Duration) {
 	metrics.KVStoreEventsQueueDuration.WithLabelValues(scope, typ.String()).Observe(duration.Seconds())
 }
 
+func recordLeaseCount(leases, lockLeases uint32) {
+	if !metrics.KVStoreEtcdLeases.IsEnabled() {
+		return
+	}
+	metrics.KVStoreEtcdLeases.WithLabelValues("lease").Set(float64(leases))
+	metrics.KVStoreEtcdLeases.WithLabelValues("lock-lease").Set(float64(lockLeases))
+}
+
 func recordQuorumError(err string) {
 	if !metrics.KVStoreQuorumErrors.IsEnabled() {
 		return
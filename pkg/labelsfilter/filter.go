@@ -218,6 +218,7 @@ func defaultLabelPrefixCfg() *labelPrefixCfg {
 		reservedLabelsPattern,                                           // include all reserved labels
 		regexp.QuoteMeta(k8sConst.PodNamespaceLabel),                    // include io.kubernetes.pod.namespace
 		regexp.QuoteMeta(k8sConst.PodNamespaceMetaLabels),               // include all namespace labels
+		regexp.QuoteMeta(k8sConst.PodNodeMetaLabels),                    // include all node labels
 		regexp.QuoteMeta(k8sConst.AppKubernetes),                        // include app.kubernetes.io
 		regexp.QuoteMeta(k8sConst.PolicyLabelCluster),                   // include io.cilium.k8s.policy.cluster
 		regexp.QuoteMeta(k8sConst.PolicyLabelServiceAccount),            // include io.cilium.k8s.policy.serviceaccount
@@ -452,7 +452,7 @@ func (c *lrpController) updateSkipLB(wtxn writer.WriteTxn, ws *statedb.WatchSet,
 			skiplb, _, watch, found := c.p.DesiredSkipLB.GetWatch(wtxn, desiredSkipLBPodIndex.Query(podInfo.namespacedName))
 			ws.Add(watch)
 
-			if !lrp.SkipRedirectFromBackend {
+			if !lrp.skipRedirectEnabled() {
 				if !found || len(skiplb.SkipRedirectForFrontends) == 0 {
 					// Nothing to do.
 					continue
@@ -500,7 +500,7 @@ func (c *lrpController) updateSkipLB(wtxn writer.WriteTxn, ws *statedb.WatchSet,
 }
 
 func (c *lrpController) frontendsToSkip(txn statedb.ReadTxn, ws *statedb.WatchSet, lrp *LocalRedirectPolicy) []lb.L3n4Addr {
-	if !lrp.SkipRedirectFromBackend {
+	if !lrp.skipRedirectEnabled() {
 		return nil
 	}
 
@@ -516,7 +516,8 @@ func (c *lrpController) frontendsToSkip(txn statedb.ReadTxn, ws *statedb.WatchSe
 	fes, watch := c.p.Writer.Frontends().ListWatch(txn, lb.FrontendByServiceName(targetName))
 	ws.Add(watch)
 	for fe := range fes {
-		if lrp.LRPType == lrpConfigTypeAddr || fe.RedirectTo != nil {
+		if (lrp.LRPType == lrpConfigTypeAddr || fe.RedirectTo != nil) &&
+			lrp.skipRedirectForAddr(fe.Address) {
 			feAddrs = append(feAddrs, fe.Address)
 		}
 	}
@@ -142,10 +142,41 @@ func (lrp *LocalRedirectPolicy) ServiceName() lb.ServiceName {
 	return lrpServiceName(lrp.ID)
 }
 
+// skipRedirectEnabled returns true if redirection from the backends is skipped
+// for at least one of the policy frontends.
+func (lrp *LocalRedirectPolicy) skipRedirectEnabled() bool {
+	if lrp.SkipRedirectFromBackend {
+		return true
+	}
+	for _, feM := range lrp.FrontendMappings {
+		if feM.skipRedirect {
+			return true
+		}
+	}
+	return false
+}
+
+// skipRedirectForAddr returns the effective skip-redirect behavior for the
+// given frontend address. Frontends without a matching port configuration
+// (e.g. when all service ports are redirected) fall back to the policy-wide
+// flag.
+func (lrp *LocalRedirectPolicy) skipRedirectForAddr(addr lb.L3n4Addr) bool {
+	for _, feM := range lrp.FrontendMappings {
+		if feM.feAddr.Port == addr.Port && feM.feAddr.Protocol == addr.Protocol {
+			return feM.skipRedirect
+		}
+	}
+	return lrp.SkipRedirectFromBackend
+}
+
 // feMapping stores frontend address and a list of associated backend addresses.
 type feMapping struct {
 	feAddr lb.L3n4Addr
 	fePort lb.FEPortName
+	// skipRedirect is the effective skip-redirect behavior for this frontend,
+	// with the per-port override taking precedence over the policy-wide
+	// SkipRedirectFromBackend flag.
+	skipRedirect bool
 }
 
 func (m feMapping) String() string {
@@ -188,6 +219,16 @@ func parseLRP(clrp *v2.CiliumLocalRedirectPolicy, sanitize bool) (*LocalRedirect
 	}
 }
 
+// skipRedirectForPort resolves the effective skip-redirect behavior for a
+// frontend port, with the per-port override taking precedence over the
+// policy-wide SkipRedirectFromBackend flag.
+func skipRedirectForPort(portInfo v2.PortInfo, spec v2.CiliumLocalRedirectPolicySpec) bool {
+	if portInfo.SkipRedirectFromBackend != nil {
+		return *portInfo.SkipRedirectFromBackend
+	}
+	return spec.SkipRedirectFromBackend
+}
+
 func getSanitizedLocalRedirectPolicy(name, namespace string, uid types.UID, spec v2.CiliumLocalRedirectPolicySpec) (*LocalRedirectPolicy, error) {
 
 	var (
@@ -234,8 +275,9 @@ func getSanitizedLocalRedirectPolicy(name, namespace string, uid types.UID, spec
 			// Set the scope to ScopeExternal as the externalTrafficPolicy is set to Cluster.
 			fe = *lb.NewL3n4Addr(proto, addrCluster, p, lb.ScopeExternal)
 			feMappings[i] = feMapping{
-				feAddr: fe,
-				fePort: lb.FEPortName(pName),
+				feAddr:       fe,
+				fePort:       lb.FEPortName(pName),
+				skipRedirect: skipRedirectForPort(portInfo, spec),
 			}
 		}
 		lrpType = lrpConfigTypeAddr
@@ -272,8 +314,9 @@ func getSanitizedLocalRedirectPolicy(name, namespace string, uid types.UID, spec
 			// frontend ip will later be populated with the clusterIP of the service.
 			fe = *lb.NewL3n4Addr(proto, cmtypes.AddrCluster{}, p, lb.ScopeExternal)
 			feMappings[i] = feMapping{
-				feAddr: fe,
-				fePort: lb.FEPortName(pName),
+				feAddr:       fe,
+				fePort:       lb.FEPortName(pName),
+				skipRedirect: skipRedirectForPort(portInfo, spec),
 			}
 		}
 		lrpType = lrpConfigTypeSvc
@@ -0,0 +1,82 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package redirectpolicy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	cmtypes "github.com/cilium/cilium/pkg/clustermesh/types"
+	v2 "github.com/cilium/cilium/pkg/k8s/apis/cilium.io/v2"
+	slim_metav1 "github.com/cilium/cilium/pkg/k8s/slim/k8s/apis/meta/v1"
+	lb "github.com/cilium/cilium/pkg/loadbalancer"
+	"github.com/cilium/cilium/pkg/policy/api"
+)
+
+// TestSkipRedirectForAddr tests the resolution of the effective skip-redirect
+// behavior for a frontend, with the per-port override taking precedence over
+// the policy-wide flag and everything else falling back to it.
+func TestSkipRedirectForAddr(t *testing.T) {
+	override := true
+	spec := v2.CiliumLocalRedirectPolicySpec{
+		RedirectFrontend: v2.RedirectFrontend{
+			AddressMatcher: &v2.Frontend{
+				IP: "169.254.169.254",
+				ToPorts: []v2.PortInfo{
+					{Port: "8080", Name: "tcp", Protocol: api.ProtoTCP, SkipRedirectFromBackend: &override},
+					{Port: "7070", Name: "udp", Protocol: api.ProtoUDP},
+				},
+			},
+		},
+		RedirectBackend: v2.RedirectBackend{
+			LocalEndpointSelector: slim_metav1.LabelSelector{
+				MatchLabels: map[string]string{"app": "proxy"},
+			},
+			ToPorts: []v2.PortInfo{
+				{Port: "80", Name: "tcp", Protocol: api.ProtoTCP},
+				{Port: "70", Name: "udp", Protocol: api.ProtoUDP},
+			},
+		},
+		SkipRedirectFromBackend: false,
+	}
+
+	lrp, err := getSanitizedLocalRedirectPolicy("test-lrp", "test", "uid", spec)
+	require.NoError(t, err)
+
+	addrCluster := cmtypes.MustParseAddrCluster("169.254.169.254")
+	tcpFE := *lb.NewL3n4Addr(lb.TCP, addrCluster, 8080, lb.ScopeExternal)
+	udpFE := *lb.NewL3n4Addr(lb.UDP, addrCluster, 7070, lb.ScopeExternal)
+	otherFE := *lb.NewL3n4Addr(lb.TCP, addrCluster, 9090, lb.ScopeExternal)
+
+	// The per-port override takes precedence over the policy-wide flag,
+	// ports without an override and frontends without a matching port
+	// configuration fall back to it.
+	require.True(t, lrp.skipRedirectForAddr(tcpFE))
+	require.False(t, lrp.skipRedirectForAddr(udpFE))
+	require.False(t, lrp.skipRedirectForAddr(otherFE))
+	require.True(t, lrp.skipRedirectEnabled())
+
+	// Flip both flags: ports without an override and unmatched frontends
+	// now skip the redirect, while the 'false' override retains it.
+	spec.SkipRedirectFromBackend = true
+	override = false
+	lrp, err = getSanitizedLocalRedirectPolicy("test-lrp", "test", "uid", spec)
+	require.NoError(t, err)
+
+	require.False(t, lrp.skipRedirectForAddr(tcpFE))
+	require.True(t, lrp.skipRedirectForAddr(udpFE))
+	require.True(t, lrp.skipRedirectForAddr(otherFE))
+	require.True(t, lrp.skipRedirectEnabled())
+
+	// Without any override the policy-wide flag applies everywhere.
+	spec.RedirectFrontend.AddressMatcher.ToPorts[0].SkipRedirectFromBackend = nil
+	lrp, err = getSanitizedLocalRedirectPolicy("test-lrp", "test", "uid", spec)
+	require.NoError(t, err)
+
+	require.True(t, lrp.skipRedirectForAddr(tcpFE))
+	require.True(t, lrp.skipRedirectForAddr(udpFE))
+	require.True(t, lrp.skipRedirectForAddr(otherFE))
+	require.True(t, lrp.skipRedirectEnabled())
+}
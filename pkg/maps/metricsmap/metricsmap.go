@@ -4,6 +4,7 @@
 package metricsmap
 
 import (
+	"strconv"
 	"unsafe"
 
 	"github.com/cilium/hive/cell"
@@ -57,10 +58,10 @@ const (
 	// MaxEntries is the maximum number of keys that can be present in the
 	// Metrics Map.
 	//
-	// Currently max. 2 bits of the Key.Dir member are used (unknown,
-	// ingress or egress). Thus we can reduce from the theoretical max. size
-	// of 2**16 (2 uint8) to 2**10 (1 uint8 + 2 bits).
-	MaxEntries = 1024
+	// Besides the reason, direction and line/file information, the key
+	// carries the endpoint ID for metrics accounted by endpoint programs.
+	// This must match METRICS_MAP_SIZE in <bpf/node_config.h>.
+	MaxEntries = 65536
 	// dirIngress and dirEgress values should match with
 	// METRIC_INGRESS, METRIC_EGRESS and METRIC_SERVICE
 	// in bpf/lib/common.h
@@ -88,8 +89,11 @@ type Key struct {
 	// Line contains the line number of the metrics statement.
 	Line uint16 `align:"line"`
 	// File is the number of the source file containing the metrics statement.
-	File     uint8    `align:"file"`
-	Reserved [3]uint8 `align:"reserved"`
+	File     uint8 `align:"file"`
+	Reserved uint8 `align:"reserved"`
+	// EpID is the ID of the endpoint that accounted the metric, or 0 when
+	// the metric was accounted outside of an endpoint program.
+	EpID uint16 `align:"epid"`
 }
 
 // Value must be in sync with struct metrics_value in <bpf/lib/common.h>
@@ -163,10 +167,12 @@ func (vs Values) Bytes() uint64 {
 type metricsmapCollector struct {
 	mutex lock.Mutex
 
-	droppedCountDesc *prometheus.Desc
-	droppedByteDesc  *prometheus.Desc
-	forwardCountDesc *prometheus.Desc
-	forwardByteDesc  *prometheus.Desc
+	droppedCountDesc         *prometheus.Desc
+	droppedByteDesc          *prometheus.Desc
+	forwardCountDesc         *prometheus.Desc
+	forwardByteDesc          *prometheus.Desc
+	endpointDroppedCountDesc *prometheus.Desc
+	endpointDroppedByteDesc  *prometheus.Desc
 }
 
 func newMetricsMapCollector() prometheus.Collector {
@@ -191,9 +197,24 @@ func newMetricsMapCollector() prometheus.Collector {
 			"Total forwarded bytes, tagged by ingress/egress direction",
 			[]string{metrics.LabelDirection}, nil,
 		),
+		endpointDroppedCountDesc: prometheus.NewDesc(
+			prometheus.BuildFQName(metrics.Namespace, "", "endpoint_drop_count_total"),
+			"Total dropped packets accounted by an endpoint program, tagged by drop reason, ingress/egress direction and endpoint ID",
+			[]string{metrics.LabelDropReason, metrics.LabelDirection, labelEndpoint}, nil,
+		),
+		endpointDroppedByteDesc: prometheus.NewDesc(
+			prometheus.BuildFQName(metrics.Namespace, "", "endpoint_drop_bytes_total"),
+			"Total dropped bytes accounted by an endpoint program, tagged by drop reason, ingress/egress direction and endpoint ID",
+			[]string{metrics.LabelDropReason, metrics.LabelDirection, labelEndpoint}, nil,
+		),
 	}
 }
 
+// labelEndpoint carries the numeric endpoint ID of the endpoint that
+// accounted the metric. The cardinality is bounded by the number of local
+// endpoints, keeping the metrics safe to scrape on nodes with many drops.
+const labelEndpoint = "endpoint"
+
 type forwardLabels struct {
 	direction string
 }
@@ -203,6 +224,12 @@ type dropLabels struct {
 	reason    string
 }
 
+type endpointDropLabels struct {
+	direction string
+	reason    string
+	endpoint  string
+}
+
 type metricValues struct {
 	bytes float64
 	count float64
@@ -259,6 +286,7 @@ func (mc *metricsmapCollector) Collect(ch chan<- prometheus.Metric) {
 	// The Prometheus library rejects metrics with duplicate label sets.
 
 	drop := make(promMetrics[dropLabels])
+	epDrop := make(promMetrics[endpointDropLabels])
 	fwd := make(promMetrics[forwardLabels])
 
 	err := Metrics.IterateWithCallback(func(key *Key, values *Values) {
@@ -269,6 +297,17 @@ func (mc *metricsmapCollector) Collect(ch chan<- prometheus.Metric) {
 			}
 			drop.sum(labelSet, values)
 
+			// Metrics accounted by endpoint programs are additionally
+			// exposed with the endpoint ID dimension, so that drops can be
+			// attributed to individual workloads without Hubble.
+			if key.EpID != 0 {
+				epDrop.sum(endpointDropLabels{
+					direction: key.Direction(),
+					reason:    key.DropForwardReason(),
+					endpoint:  strconv.FormatUint(uint64(key.EpID), 10),
+				}, values)
+			}
+
 			return
 		}
 
@@ -292,6 +331,11 @@ func (mc *metricsmapCollector) Collect(ch chan<- prometheus.Metric) {
 		mc.updateCounterMetric(mc.droppedCountDesc, ch, value.count, labels.reason, labels.direction)
 		mc.updateCounterMetric(mc.droppedByteDesc, ch, value.bytes, labels.reason, labels.direction)
 	}
+
+	for labels, value := range epDrop {
+		mc.updateCounterMetric(mc.endpointDroppedCountDesc, ch, value.count, labels.reason, labels.direction, labels.endpoint)
+		mc.updateCounterMetric(mc.endpointDroppedByteDesc, ch, value.bytes, labels.reason, labels.direction, labels.endpoint)
+	}
 }
 
 func (mc *metricsmapCollector) updateCounterMetric(desc *prometheus.Desc, metricsChan chan<- prometheus.Metric, value float64, labelValues ...string) {
@@ -307,6 +351,8 @@ func (mc *metricsmapCollector) Describe(ch chan<- *prometheus.Desc) {
 	ch <- mc.forwardCountDesc
 	ch <- mc.droppedCountDesc
 	ch <- mc.droppedByteDesc
+	ch <- mc.endpointDroppedCountDesc
+	ch <- mc.endpointDroppedByteDesc
 }
 
 func RegisterCollector() {
@@ -84,6 +84,7 @@ const (
 	// keep in sync with defines in <bpf/lib/ratelimit.h>
 	ICMPV6 usageType = iota + 1
 	EVENTS_MAP
+	SERVICE
 )
 
 func (t usageType) String() string {
@@ -92,6 +93,8 @@ func (t usageType) String() string {
 		return "icmpv6"
 	case EVENTS_MAP:
 		return "events"
+	case SERVICE:
+		return "service"
 	}
 
 	return ""
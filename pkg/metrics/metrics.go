@@ -71,6 +71,9 @@ const (
 	// SubsystemAPILimiter is the subsystem to scope metrics related to the API limiter package.
 	SubsystemAPILimiter = "api_limiter"
 
+	// SubsystemWireguard is the subsystem to scope metrics related to the WireGuard agent.
+	SubsystemWireguard = "wireguard"
+
 	// CiliumAgentNamespace is used to scope metrics from the Cilium Agent
 	CiliumAgentNamespace = "cilium"
 
@@ -318,6 +321,10 @@ var (
 	// endpoints, labeled by span name and status ("success" or "failure")
 	EndpointRegenerationTimeStats = NoOpObserverVec
 
+	// EndpointCreationTimeStats is the time taken to create endpoints via
+	// the API, labeled by outcome ("success" or "fail")
+	EndpointCreationTimeStats = NoOpObserverVec
+
 	// EndpointPropagationDelay is the delay between creation of local CiliumEndpoint
 	// and update for that CiliumEndpoint received through CiliumEndpointSlice.
 	// Measure of local CEP roundtrip time with CiliumEndpointSlice feature enabled.
@@ -404,6 +411,11 @@ var (
 
 	// Datapath statistics
 
+	// DatapathCompilations is the number of datapath object compilations
+	// performed on the node. Compilations are only expected for datapath
+	// configurations which are not covered by the pre-compiled objects.
+	DatapathCompilations = NoOpCounterVec
+
 	// ConntrackGCRuns is the number of times that the conntrack GC
 	// process was run.
 	ConntrackGCRuns = NoOpCounterVec
@@ -507,6 +519,11 @@ var (
 	// KVStoreQuorumErrors records the number of kvstore quorum errors
 	KVStoreQuorumErrors = NoOpCounterVec
 
+	// KVStoreEtcdLeases records the number of etcd leases currently held,
+	// by lease purpose. Keys are attached to shared leases, hence this
+	// number is expected to stay well below the number of owned keys.
+	KVStoreEtcdLeases = NoOpGaugeVec
+
 	// FQDNGarbageCollectorCleanedTotal is the number of domains cleaned by the
 	// GC job.
 	FQDNGarbageCollectorCleanedTotal = NoOpCounter
@@ -554,6 +571,15 @@ var (
 	// VersionMetric labelled by Cilium version
 	VersionMetric = NoOpGaugeVec
 
+	// WireguardPeers is the number of WireGuard peers configured on this node.
+	WireguardPeers = NoOpGauge
+
+	// WireguardStalePeerHandshakes is the number of WireGuard peers with a
+	// resolved endpoint whose last handshake is older than the rekey timeout,
+	// indicating that the tunnel is failing to (re-)establish, e.g. because a
+	// stateful firewall expired the UDP flow.
+	WireguardStalePeerHandshakes = NoOpGauge
+
 	// APILimiterWaitHistoryDuration is a histogram that measures the
 	// individual wait durations of API limiters
 	APILimiterWaitHistoryDuration = NoOpObserverVec
@@ -669,6 +695,7 @@ type LegacyMetrics struct {
 	EndpointRegenerationTotal        metric.Vec[metric.Counter]
 	EndpointStateCount               metric.Vec[metric.Gauge]
 	EndpointRegenerationTimeStats    metric.Vec[metric.Observer]
+	EndpointCreationTimeStats        metric.Vec[metric.Observer]
 	EndpointPropagationDelay         metric.Vec[metric.Observer]
 	Policy                           metric.Gauge
 	PolicyRegenerationCount          metric.Counter
@@ -687,6 +714,7 @@ type LegacyMetrics struct {
 	ProxyPolicyL7Total               metric.Vec[metric.Counter]
 	ProxyUpstreamTime                metric.Vec[metric.Observer]
 	ProxyDatapathUpdateTimeout       metric.Counter
+	DatapathCompilations             metric.Vec[metric.Counter]
 	ConntrackGCRuns                  metric.Vec[metric.Counter]
 	ConntrackGCKeyFallbacks          metric.Vec[metric.Counter]
 	ConntrackGCSize                  metric.Vec[metric.Gauge]
@@ -713,6 +741,7 @@ type LegacyMetrics struct {
 	KVStoreOperationsDuration        metric.Vec[metric.Observer]
 	KVStoreEventsQueueDuration       metric.Vec[metric.Observer]
 	KVStoreQuorumErrors              metric.Vec[metric.Counter]
+	KVStoreEtcdLeases                metric.Vec[metric.Gauge]
 	FQDNGarbageCollectorCleanedTotal metric.Counter
 	FQDNActiveNames                  metric.Vec[metric.Gauge]
 	FQDNActiveIPs                    metric.Vec[metric.Gauge]
@@ -725,6 +754,8 @@ type LegacyMetrics struct {
 	BPFMapOps                        metric.Vec[metric.Counter]
 	BPFMapCapacity                   metric.Vec[metric.Gauge]
 	VersionMetric                    metric.Vec[metric.Gauge]
+	WireguardPeers                   metric.Gauge
+	WireguardStalePeerHandshakes     metric.Gauge
 	APILimiterWaitHistoryDuration    metric.Vec[metric.Observer]
 	APILimiterWaitDuration           metric.Vec[metric.Gauge]
 	APILimiterProcessingDuration     metric.Vec[metric.Gauge]
@@ -790,6 +821,14 @@ func NewLegacyMetrics() *LegacyMetrics {
 			Help:      "Endpoint regeneration time stats labeled by the scope",
 		}, []string{LabelScope, LabelStatus}),
 
+		EndpointCreationTimeStats: metric.NewHistogramVec(metric.HistogramOpts{
+			ConfigName: Namespace + "_endpoint_creation_time_stats_seconds",
+
+			Namespace: Namespace,
+			Name:      "endpoint_creation_time_stats_seconds",
+			Help:      "Endpoint creation time stats labeled by the outcome",
+		}, []string{LabelOutcome}),
+
 		Policy: metric.NewGauge(metric.GaugeOpts{
 			ConfigName: Namespace + "_policy",
 			Namespace:  Namespace,
@@ -941,6 +980,14 @@ func NewLegacyMetrics() *LegacyMetrics {
 			Help:      "Number of total datapath update timeouts due to FQDN IP updates",
 		}),
 
+		DatapathCompilations: metric.NewCounterVec(metric.CounterOpts{
+			ConfigName: Namespace + "_" + SubsystemDatapath + "_compilations_total",
+			Namespace:  Namespace,
+			Subsystem:  SubsystemDatapath,
+			Name:       "compilations_total",
+			Help:       "Number of datapath object compilations performed on the node labeled by outcome",
+		}, []string{LabelOutcome}),
+
 		ConntrackGCRuns: metric.NewCounterVec(metric.CounterOpts{
 			ConfigName: Namespace + "_" + SubsystemDatapath + "_conntrack_gc_runs_total",
 			Namespace:  Namespace,
@@ -1144,6 +1191,14 @@ func NewLegacyMetrics() *LegacyMetrics {
 			Help:       "Number of quorum errors",
 		}, []string{LabelError}),
 
+		KVStoreEtcdLeases: metric.NewGaugeVec(metric.GaugeOpts{
+			ConfigName: Namespace + "_" + SubsystemKVStore + "_etcd_leases",
+			Namespace:  Namespace,
+			Subsystem:  SubsystemKVStore,
+			Name:       "etcd_leases",
+			Help:       "Number of etcd leases currently held, by lease purpose",
+		}, []string{LabelType}),
+
 		IPCacheErrorsTotal: metric.NewCounterVec(metric.CounterOpts{
 			ConfigName: Namespace + "_" + SubsystemIPCache + "_errors_total",
 			Namespace:  Namespace,
@@ -1245,6 +1300,22 @@ func NewLegacyMetrics() *LegacyMetrics {
 			Help:       "Cilium version",
 		}, []string{LabelVersion, LabelVersionRevision, LabelArch}),
 
+		WireguardPeers: metric.NewGauge(metric.GaugeOpts{
+			ConfigName: Namespace + "_" + SubsystemWireguard + "_peers",
+			Namespace:  Namespace,
+			Subsystem:  SubsystemWireguard,
+			Name:       "peers",
+			Help:       "Number of WireGuard peers configured on this node",
+		}),
+
+		WireguardStalePeerHandshakes: metric.NewGauge(metric.GaugeOpts{
+			ConfigName: Namespace + "_" + SubsystemWireguard + "_stale_peer_handshakes",
+			Namespace:  Namespace,
+			Subsystem:  SubsystemWireguard,
+			Name:       "stale_peer_handshakes",
+			Help:       "Number of WireGuard peers with a resolved endpoint whose last handshake is older than the rekey timeout",
+		}),
+
 		APILimiterWaitHistoryDuration: metric.NewHistogramVec(metric.HistogramOpts{
 			ConfigName: Namespace + "_" + SubsystemAPILimiter + "_wait_history_duration_seconds",
 			Disabled:   true,
@@ -1400,6 +1471,7 @@ func NewLegacyMetrics() *LegacyMetrics {
 	EndpointRegenerationTotal = lm.EndpointRegenerationTotal
 	EndpointStateCount = lm.EndpointStateCount
 	EndpointRegenerationTimeStats = lm.EndpointRegenerationTimeStats
+	EndpointCreationTimeStats = lm.EndpointCreationTimeStats
 	EndpointPropagationDelay = lm.EndpointPropagationDelay
 	Policy = lm.Policy
 	PolicyRegenerationCount = lm.PolicyRegenerationCount
@@ -1418,6 +1490,7 @@ func NewLegacyMetrics() *LegacyMetrics {
 	ProxyPolicyL7Total = lm.ProxyPolicyL7Total
 	ProxyUpstreamTime = lm.ProxyUpstreamTime
 	ProxyDatapathUpdateTimeout = lm.ProxyDatapathUpdateTimeout
+	DatapathCompilations = lm.DatapathCompilations
 	ConntrackGCRuns = lm.ConntrackGCRuns
 	ConntrackGCKeyFallbacks = lm.ConntrackGCKeyFallbacks
 	ConntrackGCSize = lm.ConntrackGCSize
@@ -1444,6 +1517,7 @@ func NewLegacyMetrics() *LegacyMetrics {
 	KVStoreOperationsDuration = lm.KVStoreOperationsDuration
 	KVStoreEventsQueueDuration = lm.KVStoreEventsQueueDuration
 	KVStoreQuorumErrors = lm.KVStoreQuorumErrors
+	KVStoreEtcdLeases = lm.KVStoreEtcdLeases
 	FQDNGarbageCollectorCleanedTotal = lm.FQDNGarbageCollectorCleanedTotal
 	FQDNActiveNames = lm.FQDNActiveNames
 	FQDNActiveIPs = lm.FQDNActiveIPs
@@ -1456,6 +1530,8 @@ func NewLegacyMetrics() *LegacyMetrics {
 	BPFMapOps = lm.BPFMapOps
 	BPFMapCapacity = lm.BPFMapCapacity
 	VersionMetric = lm.VersionMetric
+	WireguardPeers = lm.WireguardPeers
+	WireguardStalePeerHandshakes = lm.WireguardStalePeerHandshakes
 	APILimiterWaitHistoryDuration = lm.APILimiterWaitHistoryDuration
 	APILimiterWaitDuration = lm.APILimiterWaitDuration
 	APILimiterProcessingDuration = lm.APILimiterProcessingDuration
@@ -1537,6 +1613,20 @@ func NewBPFMapPressureGauge(mapname string, threshold float64) *GaugeWithThresho
 	)
 }
 
+// NewBPFMapPeakPressureGauge creates a new GaugeWithThreshold for the
+// cilium_bpf_map_pressure_peak metric with the map name as constant label.
+func NewBPFMapPeakPressureGauge(mapname string, threshold float64) *GaugeWithThreshold {
+	return NewGaugeWithThreshold(
+		"map_pressure_peak",
+		SubsystemBPF,
+		"Peak fill percentage of map observed since the agent started, tagged by map name",
+		map[string]string{
+			LabelMapName: mapname,
+		},
+		threshold,
+	)
+}
+
 func Reinitialize() {
 	reg, err := registry.Await(context.Background())
 	if err == nil {
@@ -536,6 +536,10 @@ const (
 
 	// PolicyMatchProtoOnly is the value of MatchType indicating only a protocol match
 	PolicyMatchProtoOnly = 6
+
+	// PolicyMatchImplicitLoopback is the value of MatchType indicating an
+	// implicit allow of loopback traffic not subject to any policy rule
+	PolicyMatchImplicitLoopback = 7
 )
 
 type PolicyMatchType int
@@ -556,6 +560,8 @@ func (m PolicyMatchType) String() string {
 		return "L3-Proto"
 	case PolicyMatchProtoOnly:
 		return "Proto-Only"
+	case PolicyMatchImplicitLoopback:
+		return "implicit-loopback"
 	}
 	return "unknown"
 }
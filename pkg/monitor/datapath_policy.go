@@ -40,6 +40,10 @@ const (
 	// PolicyVerdictNotifyFlagMatchTypeBitOffset is the bit offset in Flags that
 	// corresponds to the policy match type
 	PolicyVerdictNotifyFlagMatchTypeBitOffset = 3
+
+	// PolicyVerdictNotifyFlagIsL3Device is the bit mask in Flags that
+	// corresponds to whether the traffic comes from a L3 device
+	PolicyVerdictNotifyFlagIsL3Device = 0x80
 )
 
 // PolicyVerdictNotify is the message format of a policy verdict notification in the bpf ring buffer
@@ -101,6 +105,11 @@ func (n *PolicyVerdictNotify) IsTrafficIPv6() bool {
 	return (n.Flags&PolicyVerdictNotifyFlagIsIPv6 > 0)
 }
 
+// IsTrafficL3Device returns true if this notify is for traffic on a L3 device
+func (n *PolicyVerdictNotify) IsTrafficL3Device() bool {
+	return (n.Flags&PolicyVerdictNotifyFlagIsL3Device > 0)
+}
+
 // GetPolicyMatchType returns how the traffic matched the policy
 func (n *PolicyVerdictNotify) GetPolicyMatchType() api.PolicyMatchType {
 	return api.PolicyMatchType((n.Flags & PolicyVerdictNotifyFlagMatchType) >>
@@ -149,6 +158,6 @@ func (n *PolicyVerdictNotify) DumpInfo(data []byte, numeric DisplayFormat) {
 	fmt.Fprintf(buf, ", proto %d, %s, action %s, auth: %s, match %s, %s\n", n.Proto, dir,
 		GetPolicyActionString(n.Verdict, n.IsTrafficAudited()),
 		n.GetAuthType(), n.GetPolicyMatchType(),
-		GetConnectionSummary(data[PolicyVerdictNotifyLen:], nil))
+		GetConnectionSummary(data[PolicyVerdictNotifyLen:], &decodeOpts{n.IsTrafficL3Device(), n.IsTrafficIPv6()}))
 	buf.Flush()
 }
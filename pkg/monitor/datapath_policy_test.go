@@ -30,7 +30,7 @@ func TestDecodePolicyVerdicyNotify(t *testing.T) {
 		Verdict:     0x15_16_17_18,
 		DstPort:     0x19_1a,
 		Proto:       0x1b,
-		Flags:       0x1c,
+		Flags:       0x1c | PolicyVerdictNotifyFlagIsL3Device,
 		AuthType:    0x1d,
 		Pad1:        0x1e,
 		Pad2:        0x20_21,
@@ -55,6 +55,8 @@ func TestDecodePolicyVerdicyNotify(t *testing.T) {
 	require.Equal(t, input.DstPort, output.DstPort)
 	require.Equal(t, input.Proto, output.Proto)
 	require.Equal(t, input.Flags, output.Flags)
+	require.True(t, output.IsTrafficIPv6())
+	require.True(t, output.IsTrafficL3Device())
 	require.Equal(t, input.AuthType, output.AuthType)
 	require.Equal(t, input.Pad1, output.Pad1)
 	require.Equal(t, input.Pad2, output.Pad2)
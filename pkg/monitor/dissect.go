@@ -220,6 +220,40 @@ func GetConnectionSummary(data []byte, opts *decodeOpts) string {
 	return "[unknown]"
 }
 
+// GetConnectionAddrs decodes the given packet data and returns the source and
+// destination IP addresses. ok is false if the data could not be decoded or
+// does not contain an IP layer.
+func GetConnectionAddrs(data []byte, l3Device, ipv6 bool) (srcIP, dstIP net.IP, ok bool) {
+	dissectLock.Lock()
+	defer dissectLock.Unlock()
+
+	initParser()
+
+	// See comment in [GetConnectionSummary].
+	if len(data) > 0 {
+		var err error
+		switch {
+		case !l3Device:
+			err = parserL2Dev.DecodeLayers(data, &cache.decoded)
+		case ipv6:
+			err = parserL3Dev.IPv6.DecodeLayers(data, &cache.decoded)
+		default:
+			err = parserL3Dev.IPv4.DecodeLayers(data, &cache.decoded)
+		}
+		if err != nil {
+			return nil, nil, false
+		}
+	} else {
+		cache.decoded = cache.decoded[:0]
+	}
+
+	c, hasIP, _ := getConnectionInfoFromCache()
+	if !hasIP {
+		return nil, nil, false
+	}
+	return c.SrcIP, c.DstIP, true
+}
+
 // Dissect parses and prints the provided data if dissect is set to true,
 // otherwise the data is printed as HEX output
 func Dissect(dissect bool, data []byte) {
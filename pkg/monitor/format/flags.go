@@ -4,6 +4,9 @@
 package format
 
 import (
+	"fmt"
+	"net"
+	"net/netip"
 	"slices"
 	"strconv"
 	"strings"
@@ -45,3 +48,42 @@ func (i *Uint16Flags) Type() string {
 func (i *Uint16Flags) Has(value uint16) bool {
 	return slices.Contains(*i, value)
 }
+
+// IPFlags is a slice of IP addresses with some convenience methods.
+type IPFlags []netip.Addr
+
+var _ pflag.Value = &IPFlags{}
+
+// String provides a human-readable string format of the received variable.
+func (i *IPFlags) String() string {
+	pieces := make([]string, 0, len(*i))
+	for _, v := range *i {
+		pieces = append(pieces, v.String())
+	}
+	return strings.Join(pieces, ", ")
+}
+
+// Set converts the specified value into an IP address and appends it to the
+// flags. Returns an error if the value is not a valid IP address.
+func (i *IPFlags) Set(value string) error {
+	addr, err := netip.ParseAddr(value)
+	if err != nil {
+		return fmt.Errorf("invalid IP address %q", value)
+	}
+	*i = append(*i, addr.Unmap())
+	return nil
+}
+
+// Type returns a human-readable string representing the type of the receiver.
+func (i *IPFlags) Type() string {
+	return "[]ip"
+}
+
+// Has returns true if the given IP is in the flags.
+func (i *IPFlags) Has(value net.IP) bool {
+	addr, ok := netip.AddrFromSlice(value)
+	if !ok {
+		return false
+	}
+	return slices.Contains(*i, addr.Unmap())
+}
@@ -41,6 +41,7 @@ type MonitorFormatter struct {
 	FromSource Uint16Flags
 	ToDst      Uint16Flags
 	Related    Uint16Flags
+	RelatedIPs IPFlags
 	Hex        bool
 	JSONOutput bool
 	Verbosity  Verbosity
@@ -57,6 +58,7 @@ func NewMonitorFormatter(verbosity Verbosity, linkMonitor getters.LinkGetter) *M
 		FromSource:  Uint16Flags{},
 		ToDst:       Uint16Flags{},
 		Related:     Uint16Flags{},
+		RelatedIPs:  IPFlags{},
 		JSONOutput:  false,
 		Verbosity:   verbosity,
 		Numeric:     bool(monitor.DisplayLabel),
@@ -82,6 +84,23 @@ func (m *MonitorFormatter) match(messageType int, src uint16, dst uint16) bool {
 	return true
 }
 
+// matchIP checks the packet in data against the IP filter, matching on either
+// the source or destination address. Events whose packet data cannot be
+// decoded are filtered out when an IP filter is set.
+func (m *MonitorFormatter) matchIP(data []byte, offset uint, l3Device, ipv6 bool) bool {
+	if len(m.RelatedIPs) == 0 {
+		return true
+	}
+	if uint(len(data)) < offset {
+		return false
+	}
+	src, dst, ok := monitor.GetConnectionAddrs(data[offset:], l3Device, ipv6)
+	if !ok {
+		return false
+	}
+	return m.RelatedIPs.Has(src) || m.RelatedIPs.Has(dst)
+}
+
 // dropEvents prints out all the received drop notifications.
 func (m *MonitorFormatter) dropEvents(prefix string, data []byte) {
 	dn := monitor.DropNotify{}
@@ -89,7 +108,8 @@ func (m *MonitorFormatter) dropEvents(prefix string, data []byte) {
 	if err := binary.Read(bytes.NewReader(data), byteorder.Native, &dn); err != nil {
 		fmt.Printf("Error while parsing drop notification message: %s\n", err)
 	}
-	if m.match(monitorAPI.MessageTypeDrop, dn.Source, uint16(dn.DstID)) {
+	if m.match(monitorAPI.MessageTypeDrop, dn.Source, uint16(dn.DstID)) &&
+		m.matchIP(data, dn.DataOffset(), dn.IsL3Device(), dn.IsIPv6()) {
 		switch m.Verbosity {
 		case INFO, DEBUG:
 			dn.DumpInfo(data, monitor.DisplayFormat(m.Numeric))
@@ -109,7 +129,8 @@ func (m *MonitorFormatter) traceEvents(prefix string, data []byte) {
 	if err := monitor.DecodeTraceNotify(data, &tn); err != nil {
 		fmt.Printf("Error while parsing trace notification message: %s\n", err)
 	}
-	if m.match(monitorAPI.MessageTypeTrace, tn.Source, tn.DstID) {
+	if m.match(monitorAPI.MessageTypeTrace, tn.Source, tn.DstID) &&
+		m.matchIP(data, tn.DataOffset(), tn.IsL3Device(), tn.IsIPv6()) {
 		switch m.Verbosity {
 		case INFO, DEBUG:
 			tn.DumpInfo(data, monitor.DisplayFormat(m.Numeric), m.linkMonitor)
@@ -142,7 +163,8 @@ func (m *MonitorFormatter) policyVerdictEvents(prefix string, data []byte) {
 		fmt.Printf("Error while parsing policy notification message: %s\n", err)
 	}
 
-	if m.match(monitorAPI.MessageTypePolicyVerdict, pn.Source, uint16(pn.RemoteLabel)) {
+	if m.match(monitorAPI.MessageTypePolicyVerdict, pn.Source, uint16(pn.RemoteLabel)) &&
+		m.matchIP(data, monitor.PolicyVerdictNotifyLen, pn.IsTrafficL3Device(), pn.IsTrafficIPv6()) {
 		pn.DumpInfo(data, monitor.DisplayFormat(m.Numeric))
 	}
 }
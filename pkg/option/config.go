@@ -77,6 +77,10 @@ const (
 	// endpoints
 	AllowLocalhostAlways = "always"
 
+	// AllowLocalhostPorts restricts the implicit allow from the local stack
+	// to a list of port/protocol pairs (e.g. kubelet probe ports)
+	AllowLocalhostPorts = "allow-localhost-ports"
+
 	// AllowLocalhostPolicy requires a policy rule to allow the local stack
 	// to reach particular endpoints or policy enforcement must be
 	// disabled.
@@ -1119,6 +1123,14 @@ const (
 	// EnableNodeSelectorLabels)
 	NodeLabels = "node-labels"
 
+	// EnablePodSecurityLabels enables derivation of well-known security
+	// labels from the pod specification into the endpoint labels
+	EnablePodSecurityLabels = "enable-pod-security-labels"
+
+	// EnablePodTopologyLabels enables derivation of the topology labels of
+	// the node the pod is running on into the endpoint labels
+	EnablePodTopologyLabels = "enable-pod-topology-labels"
+
 	// BPFEventsDropEnabled defines the DropNotification setting for any endpoint
 	BPFEventsDropEnabled = "bpf-events-drop-enabled"
 
@@ -1378,6 +1390,11 @@ type DaemonConfig struct {
 	// values: { auto | always | policy }
 	AllowLocalhost string
 
+	// AllowLocalhostPorts restricts the implicit allow from the local stack
+	// to the given "port[/protocol]" pairs, e.g. the kubelet probe ports.
+	// Empty means the implicit allow covers all ports.
+	AllowLocalhostPorts []string
+
 	// StateDir is the directory where runtime state of endpoints is stored
 	StateDir string
 
@@ -2238,6 +2255,14 @@ type DaemonConfig struct {
 	// EnableNodeSelectorLabels)
 	NodeLabels []string
 
+	// EnablePodSecurityLabels enables derivation of well-known security
+	// labels from the pod specification into the endpoint labels
+	EnablePodSecurityLabels bool
+
+	// EnablePodTopologyLabels enables derivation of the topology labels of
+	// the node the pod is running on into the endpoint labels
+	EnablePodTopologyLabels bool
+
 	// EnableSocketLBPodConnectionTermination enables the termination of connections from pods
 	// to deleted service backends when socket-LB is enabled
 	EnableSocketLBPodConnectionTermination bool
@@ -2843,6 +2868,7 @@ func (c *DaemonConfig) Populate(vp *viper.Viper) {
 	c.ClusterMeshHealthPort = vp.GetInt(ClusterMeshHealthPort)
 	c.AllowICMPFragNeeded = vp.GetBool(AllowICMPFragNeeded)
 	c.AllowLocalhost = vp.GetString(AllowLocalhost)
+	c.AllowLocalhostPorts = vp.GetStringSlice(AllowLocalhostPorts)
 	c.AnnotateK8sNode = vp.GetBool(AnnotateK8sNode)
 	c.ARPPingRefreshPeriod = vp.GetDuration(ARPPingRefreshPeriod)
 	c.EnableL2NeighDiscovery = vp.GetBool(EnableL2NeighDiscovery)
@@ -3254,6 +3280,8 @@ func (c *DaemonConfig) Populate(vp *viper.Viper) {
 	c.PolicyCIDRMatchMode = vp.GetStringSlice(PolicyCIDRMatchMode)
 	c.EnableNodeSelectorLabels = vp.GetBool(EnableNodeSelectorLabels)
 	c.NodeLabels = vp.GetStringSlice(NodeLabels)
+	c.EnablePodSecurityLabels = vp.GetBool(EnablePodSecurityLabels)
+	c.EnablePodTopologyLabels = vp.GetBool(EnablePodTopologyLabels)
 
 	c.EnableCiliumNetworkPolicy = vp.GetBool(EnableCiliumNetworkPolicy)
 	c.EnableCiliumClusterwideNetworkPolicy = vp.GetBool(EnableCiliumClusterwideNetworkPolicy)
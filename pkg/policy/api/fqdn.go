@@ -125,8 +125,59 @@ func (s *FQDNSelector) ToRegex() (*regexp.Regexp, error) {
 	return regex, err
 }
 
+const (
+	// DNSResponsePolicyAllow forwards queries for matched names to the
+	// upstream DNS server. This is the default when no response policy is
+	// specified.
+	DNSResponsePolicyAllow = "Allow"
+
+	// DNSResponsePolicyDeny refuses resolution of matched names with an
+	// NXDOMAIN response without contacting the upstream DNS server.
+	DNSResponsePolicyDeny = "Deny"
+)
+
 // PortRuleDNS is a list of allowed DNS lookups.
-type PortRuleDNS FQDNSelector
+type PortRuleDNS struct {
+	// MatchName matches literal DNS names. A trailing "." is automatically added
+	// when missing.
+	//
+	// +kubebuilder:validation:MaxLength=255
+	// +kubebuilder:validation:Pattern=`^([-a-zA-Z0-9_]+[.]?)+$`
+	// +kubebuilder:validation:OneOf
+	MatchName string `json:"matchName,omitempty"`
+
+	// MatchPattern allows using wildcards to match DNS names. All wildcards are
+	// case insensitive. The wildcards are:
+	// - "*" matches 0 or more DNS valid characters, and may occur anywhere in
+	// the pattern. As a special case a "*" as the leftmost character, without a
+	// following "." matches all subdomains as well as the name to the right.
+	// A trailing "." is automatically added when missing.
+	//
+	// Examples:
+	// `*.cilium.io` matches subdomains of cilium at that level
+	//   www.cilium.io and blog.cilium.io match, cilium.io and google.com do not
+	// `*cilium.io` matches cilium.io and all subdomains ends with "cilium.io"
+	//   except those containing "." separator, subcilium.io and sub-cilium.io match,
+	//   www.cilium.io and blog.cilium.io does not
+	// sub*.cilium.io matches subdomains of cilium where the subdomain component
+	// begins with "sub"
+	//   sub.cilium.io and subdomain.cilium.io match, www.cilium.io,
+	//   blog.cilium.io, cilium.io and google.com do not
+	//
+	// +kubebuilder:validation:MaxLength=255
+	// +kubebuilder:validation:Pattern=`^([-a-zA-Z0-9_*]+[.]?)+$`
+	// +kubebuilder:validation:OneOf
+	MatchPattern string `json:"matchPattern,omitempty"`
+
+	// ResponsePolicy determines how the DNS proxy answers queries for
+	// matched names. "Allow" (the default) forwards the query to the
+	// upstream DNS server, "Deny" refuses resolution with an NXDOMAIN
+	// response without contacting the upstream DNS server.
+	//
+	// +kubebuilder:validation:Enum=Allow;Deny
+	// +kubebuilder:validation:Optional
+	ResponsePolicy string `json:"responsePolicy,omitempty"`
+}
 
 // Sanitize checks that the matchName in the portRule can be compiled as a
 // regex. It does not check that a DNS name is a valid DNS name.
@@ -138,10 +189,22 @@ func (r *PortRuleDNS) Sanitize() error {
 	if len(r.MatchPattern) > 0 && !allowedPatternChars.MatchString(r.MatchPattern) {
 		return fmt.Errorf("Invalid characters in MatchPattern: \"%s\". Only 0-9, a-z, A-Z and ., - and * characters are allowed", r.MatchPattern)
 	}
+
+	switch r.ResponsePolicy {
+	case "", DNSResponsePolicyAllow, DNSResponsePolicyDeny:
+	default:
+		return fmt.Errorf("Invalid ResponsePolicy: %q. Only %q and %q are allowed", r.ResponsePolicy, DNSResponsePolicyAllow, DNSResponsePolicyDeny)
+	}
+
 	_, err := matchpattern.Validate(r.MatchPattern)
 	return err
 }
 
+// IsDeny returns true if the rule refuses resolution of matched names.
+func (r *PortRuleDNS) IsDeny() bool {
+	return r.ResponsePolicy == DNSResponsePolicyDeny
+}
+
 // GetAsEndpointSelectors returns a FQDNSelector as a single EntityNone
 // EndpointSelector slice.
 // Note that toFQDNs behaves differently than most other rules. The presence of
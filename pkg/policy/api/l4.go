@@ -208,7 +208,9 @@ type PortRule struct {
 	// parameters for the upstream connection originating from the L7 proxy
 	// to the remote destination. For ingress policy this specifies the
 	// client-side TLS parameters for the connection from the L7 proxy to
-	// the local endpoint.
+	// the local endpoint. If the 'certificate' or 'privateKey' fields are
+	// set, the proxy presents the selected client certificate from the
+	// secret to the remote peer, originating mutual TLS.
 	//
 	// +kubebuilder:validation:Optional
 	OriginatingTLS *TLSContext `json:"originatingTLS,omitempty"`
@@ -646,12 +646,20 @@ func (pp *PortProtocol) sanitize(hasDNSRules bool) (isZero bool, err error) {
 	// 0x10 is now considered a name rather than number 16.
 	if iana.IsSvcName(pp.Port) {
 		pp.Port = strings.ToLower(pp.Port) // Normalize for case insensitive comparison
+		if pp.EndPort != 0 {
+			// Named ports are resolved to a single port number per endpoint,
+			// so a range starting at a named port is not well defined.
+			return isZero, fmt.Errorf("EndPort cannot be specified with a named port (%q)", pp.Port)
+		}
 	} else {
 		p, err := strconv.ParseUint(pp.Port, 0, 16)
 		if err != nil {
 			return isZero, fmt.Errorf("unable to parse port: %w", err)
 		}
 		isZero = p == 0
+		if pp.EndPort != 0 && pp.EndPort < int32(p) {
+			return isZero, fmt.Errorf("EndPort must be greater than or equal to Port (%d < %s)", pp.EndPort, pp.Port)
+		}
 		if hasDNSRules && pp.EndPort > int32(p) {
 			return isZero, errors.New("DNS rules do not support port ranges")
 		}
@@ -807,6 +807,48 @@ func TestPortRangesNotAllowedWithDNSRules(t *testing.T) {
 	require.Equal(t, "DNS rules do not support port ranges", err.Error())
 }
 
+// This test ensures that invalid port ranges are rejected instead of being
+// silently ignored.
+func TestPortRangeSanitize(t *testing.T) {
+	setUpSuite(t)
+
+	rangeRule := func(pp PortProtocol) Rule {
+		return Rule{
+			EndpointSelector: WildcardEndpointSelector,
+			Egress: []EgressRule{
+				{
+					EgressCommonRule: EgressCommonRule{
+						ToEndpoints: []EndpointSelector{WildcardEndpointSelector},
+					},
+					ToPorts: []PortRule{{
+						Ports: []PortProtocol{pp},
+					}},
+				},
+			},
+		}
+	}
+
+	// Valid numeric port range.
+	validPortRule := rangeRule(PortProtocol{Port: "80", EndPort: 443, Protocol: ProtoTCP})
+	require.NoError(t, validPortRule.Sanitize())
+
+	// EndPort equal to Port is a single-port range.
+	validPortRule = rangeRule(PortProtocol{Port: "80", EndPort: 80, Protocol: ProtoTCP})
+	require.NoError(t, validPortRule.Sanitize())
+
+	// A range cannot start at a named port.
+	invalidPortRule := rangeRule(PortProtocol{Port: "http-8080", EndPort: 8443, Protocol: ProtoTCP})
+	err := invalidPortRule.Sanitize()
+	require.Error(t, err)
+	require.ErrorContains(t, err, "EndPort cannot be specified with a named port")
+
+	// A range cannot end before it starts.
+	invalidPortRule = rangeRule(PortProtocol{Port: "443", EndPort: 80, Protocol: ProtoTCP})
+	err = invalidPortRule.Sanitize()
+	require.Error(t, err)
+	require.ErrorContains(t, err, "EndPort must be greater than or equal to Port")
+}
+
 // This test ensures that host policies with L7 rules (except for DNS egress) are rejected.
 func TestL7RulesWithNodeSelector(t *testing.T) {
 	setUpSuite(t)
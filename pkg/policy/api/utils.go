@@ -67,7 +67,7 @@ func (h *PortRuleL7) Exists(rules L7Rules) bool {
 
 // Equal returns true if both rules are equal
 func (d *PortRuleDNS) Equal(o PortRuleDNS) bool {
-	return d != nil && d.MatchName == o.MatchName && d.MatchPattern == o.MatchPattern
+	return d != nil && d.MatchName == o.MatchName && d.MatchPattern == o.MatchPattern && d.ResponsePolicy == o.ResponsePolicy
 }
 
 // Equal returns true if both L7 rules are equal
@@ -1097,6 +1097,9 @@ func (in *PortRuleDNS) DeepEqual(other *PortRuleDNS) bool {
 	if in.MatchPattern != other.MatchPattern {
 		return false
 	}
+	if in.ResponsePolicy != other.ResponsePolicy {
+		return false
+	}
 
 	return true
 }
@@ -0,0 +1,110 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package policycell
+
+import (
+	"encoding/json"
+	"log/slog"
+
+	"github.com/cilium/lumberjack/v2"
+
+	"github.com/cilium/cilium/pkg/lock"
+	"github.com/cilium/cilium/pkg/logging/logfields"
+	policytypes "github.com/cilium/cilium/pkg/policy/types"
+	"github.com/cilium/cilium/pkg/time"
+)
+
+const (
+	// policyAuditLogMaxSizeMB is the size in megabytes at which the audit
+	// log file is rotated.
+	policyAuditLogMaxSizeMB = 10
+
+	// policyAuditLogMaxBackups is the number of rotated audit log files to
+	// retain.
+	policyAuditLogMaxBackups = 5
+)
+
+// policyAuditEvent is a single record in the policy change audit log. Every
+// policy import or removal applied to the repository is appended to the log
+// as one JSON-encoded line, providing a durable trail of enforcement-relevant
+// changes that is independent of the agent log and the monitor notifications.
+type policyAuditEvent struct {
+	// Timestamp is the time the change was committed to the repository.
+	Timestamp time.Time `json:"timestamp"`
+	// Action is either "upsert" or "delete".
+	Action string `json:"action"`
+	// Resource identifies the owning object (e.g. the k8s resource) of the
+	// rules, if any. Empty for rules managed via the local API.
+	Resource string `json:"resource,omitempty"`
+	// Source is the source that supplied the rules, e.g. "k8s" or "directory".
+	Source string `json:"source,omitempty"`
+	// Labels are the labels of the imported rules, or the labels used as the
+	// deletion key.
+	Labels []string `json:"labels,omitempty"`
+	// RuleCount is the number of rules imported.
+	RuleCount int `json:"ruleCount"`
+	// DeletedRuleCount is the number of rules removed from the repository by
+	// this change.
+	DeletedRuleCount int `json:"deletedRuleCount"`
+	// Revision is the policy repository revision after the change.
+	Revision uint64 `json:"revision"`
+}
+
+// policyAuditLogger appends policy change audit events to a file, rotating
+// it once it grows past policyAuditLogMaxSizeMB.
+type policyAuditLogger struct {
+	log *slog.Logger
+
+	mu  lock.Mutex
+	out *lumberjack.Logger
+	enc *json.Encoder
+}
+
+func newPolicyAuditLogger(log *slog.Logger, path string) *policyAuditLogger {
+	out := &lumberjack.Logger{
+		Filename:   path,
+		MaxSize:    policyAuditLogMaxSizeMB,
+		MaxBackups: policyAuditLogMaxBackups,
+		FileMode:   0600,
+	}
+	return &policyAuditLogger{
+		log: log,
+		out: out,
+		enc: json.NewEncoder(out),
+	}
+}
+
+// record appends an audit event for the given applied policy update.
+func (a *policyAuditLogger) record(upd *policytypes.PolicyUpdate, revision uint64, oldRuleCnt int) {
+	ev := policyAuditEvent{
+		Timestamp:        time.Now(),
+		Action:           "upsert",
+		Resource:         string(upd.Resource),
+		Source:           string(upd.Source),
+		RuleCount:        len(upd.Rules),
+		DeletedRuleCount: oldRuleCnt,
+		Revision:         revision,
+	}
+	if len(upd.Rules) == 0 {
+		ev.Action = "delete"
+		ev.Labels = upd.ReplaceWithLabels.GetModel()
+	} else {
+		for _, rule := range upd.Rules {
+			ev.Labels = append(ev.Labels, rule.Labels.GetModel()...)
+		}
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if err := a.enc.Encode(ev); err != nil {
+		a.log.Error("Failed to write policy audit event", logfields.Error, err)
+	}
+}
+
+// close closes the underlying audit log file.
+func (a *policyAuditLogger) close() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.out.Close()
+}
@@ -0,0 +1,77 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package policycell
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/cilium/hive/hivetest"
+	"github.com/stretchr/testify/require"
+
+	ipcachetypes "github.com/cilium/cilium/pkg/ipcache/types"
+	"github.com/cilium/cilium/pkg/labels"
+	policyapi "github.com/cilium/cilium/pkg/policy/api"
+	policytypes "github.com/cilium/cilium/pkg/policy/types"
+	"github.com/cilium/cilium/pkg/source"
+)
+
+func TestPolicyAuditLogger(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "policy-audit.log")
+	auditLogger := newPolicyAuditLogger(hivetest.Logger(t), path)
+
+	lbls := labels.LabelArray{labels.NewLabel("policy", "test", labels.LabelSourceK8s)}
+	resource := ipcachetypes.NewResourceID(ipcachetypes.ResourceKindCNP, "test", "policy")
+
+	auditLogger.record(&policytypes.PolicyUpdate{
+		Rules:    policyapi.Rules{{Labels: lbls}},
+		Resource: resource,
+		Source:   source.CustomResource,
+	}, 2, 1)
+	auditLogger.record(&policytypes.PolicyUpdate{
+		ReplaceWithLabels: lbls,
+		Resource:          resource,
+		Source:            source.CustomResource,
+	}, 3, 1)
+	require.NoError(t, auditLogger.close())
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	require.Len(t, lines, 2)
+
+	// The emitted field names are consumed by external tooling; check the
+	// raw JSON schema rather than just round-tripping the struct.
+	var raw map[string]any
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &raw))
+	for _, field := range []string{"timestamp", "action", "resource", "source", "labels", "ruleCount", "deletedRuleCount", "revision"} {
+		require.Contains(t, raw, field)
+	}
+
+	// An update carrying rules is classified as an upsert, with the labels
+	// taken from the rules.
+	var upsert policyAuditEvent
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &upsert))
+	require.Equal(t, "upsert", upsert.Action)
+	require.Equal(t, string(resource), upsert.Resource)
+	require.Equal(t, string(source.CustomResource), upsert.Source)
+	require.Equal(t, lbls.GetModel(), upsert.Labels)
+	require.Equal(t, 1, upsert.RuleCount)
+	require.Equal(t, 1, upsert.DeletedRuleCount)
+	require.Equal(t, uint64(2), upsert.Revision)
+	require.False(t, upsert.Timestamp.IsZero())
+
+	// An update without rules is classified as a deletion, with the labels
+	// taken from the deletion key.
+	var del policyAuditEvent
+	require.NoError(t, json.Unmarshal([]byte(lines[1]), &del))
+	require.Equal(t, "delete", del.Action)
+	require.Equal(t, lbls.GetModel(), del.Labels)
+	require.Equal(t, 0, del.RuleCount)
+	require.Equal(t, 1, del.DeletedRuleCount)
+	require.Equal(t, uint64(3), del.Revision)
+}
@@ -33,8 +33,9 @@ var Cell = cell.Module(
 )
 
 type Config struct {
-	EnableWellKnownIdentities bool `mapstructure:"enable-well-known-identities"`
-	PolicyQueueSize           uint `mapstructure:"policy-queue-size"`
+	EnableWellKnownIdentities bool   `mapstructure:"enable-well-known-identities"`
+	PolicyQueueSize           uint   `mapstructure:"policy-queue-size"`
+	PolicyAuditLogPath        string `mapstructure:"policy-audit-log-path"`
 }
 
 var defaultConfig = Config{
@@ -47,6 +48,7 @@ var defaultConfig = Config{
 func (def Config) Flags(flags *pflag.FlagSet) {
 	flags.Bool("enable-well-known-identities", def.EnableWellKnownIdentities, "Enable well-known identities for known Kubernetes components")
 	flags.Uint("policy-queue-size", def.PolicyQueueSize, "Size of queue for policy-related events")
+	flags.String("policy-audit-log-path", def.PolicyAuditLogPath, "Path to a file where policy repository changes are appended as JSON audit records (empty to disable)")
 }
 
 type policyRepoParams struct {
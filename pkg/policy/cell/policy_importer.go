@@ -38,9 +38,10 @@ type PolicyImporter interface {
 type policyImporterParams struct {
 	cell.In
 
-	Log      *slog.Logger
-	JobGroup job.Group
-	Config   Config
+	Log       *slog.Logger
+	Lifecycle cell.Lifecycle
+	JobGroup  job.Group
+	Config    Config
 
 	Repo            policy.PolicyRepository
 	EndpointManager endpointmanager.EndpointManager
@@ -55,6 +56,10 @@ type policyImporter struct {
 	ipc          ipcacher
 	monitorAgent agent.Agent
 
+	// audit, if not nil, records every applied policy change to the
+	// configured audit log file.
+	audit *policyAuditLogger
+
 	// prefixesByResources is the list of prefixes
 	// that belong to each resource. This is tracked separately
 	// so we can allocate and release prefixes as policy changes.
@@ -86,6 +91,16 @@ func newPolicyImporter(cfg policyImporterParams) PolicyImporter {
 		prefixesByResource: map[ipcachetypes.ResourceID][]netip.Prefix{},
 	}
 
+	if cfg.Config.PolicyAuditLogPath != "" {
+		audit := newPolicyAuditLogger(cfg.Log, cfg.Config.PolicyAuditLogPath)
+		i.audit = audit
+		cfg.Lifecycle.Append(cell.Hook{
+			OnStop: func(cell.HookContext) error {
+				return audit.close()
+			},
+		})
+	}
+
 	buf := stream.Buffer(
 		stream.FromChannel(i.q),
 		int(cfg.Config.PolicyQueueSize), 10*time.Millisecond,
@@ -340,6 +355,10 @@ func (i *policyImporter) processUpdates(ctx context.Context, updates []*policyty
 
 		}
 
+		if i.audit != nil {
+			i.audit.record(upd, endRevision, oldRuleCnt)
+		}
+
 		idsToRegen.Merge(*regen)
 
 		// Report that the policy has been inserted in to the repository.
@@ -52,7 +52,7 @@ var defaultConfig = Config{
 }
 
 func (cfg Config) Flags(flags *pflag.FlagSet) {
-	flags.String(staticCNPPath, defaultConfig.StaticCNPPath, "Directory path to watch and load static cilium network policy yaml files.")
+	flags.String(staticCNPPath, defaultConfig.StaticCNPPath, "Directory path to watch and load static cilium network policy yaml or json files.")
 }
 
 func providePolicyWatcher(p PolicyWatcherParams, cfg Config) DirectoryWatcherReadStatus {
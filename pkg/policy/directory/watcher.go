@@ -146,7 +146,12 @@ func (p *policyWatcher) deleteFromPolicyEngine(cnpFilePath string) error {
 }
 
 func (p *policyWatcher) isValidCNPFileName(filePath string) bool {
-	if filepath.Ext(filePath) != ".yaml" {
+	switch filepath.Ext(filePath) {
+	case ".yaml", ".yml", ".json":
+		// JSON policy files are accepted as well, since JSON is a subset of
+		// YAML. This allows loading policies dumped from another source, e.g.
+		// exported from the k8s API server, without converting them first.
+	default:
 		return false
 	}
 	if reasons := validation.IsDNS1123Subdomain(filepath.Base(filePath)); len(reasons) > 0 {
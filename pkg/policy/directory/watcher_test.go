@@ -85,6 +85,17 @@ func TestTranslateToCNPObject(t *testing.T) {
 	require.Nil(t, cnpObj, "Expected cnp object to be nil but its non nil")
 }
 
+func TestIsValidCNPFileName(t *testing.T) {
+	policyMgr := newPolicyManager()
+	p := newPolicyWatcher(PolicyWatcherParams{Logger: hivetest.Logger(t), Importer: policyMgr}, defaultConfig)
+
+	require.True(t, p.isValidCNPFileName("policy.yaml"))
+	require.True(t, p.isValidCNPFileName("policy.yml"))
+	require.True(t, p.isValidCNPFileName("policy.json"))
+	require.False(t, p.isValidCNPFileName("policy.txt"))
+	require.False(t, p.isValidCNPFileName("policy"))
+}
+
 func TestAddToPolicyEngine(t *testing.T) {
 	policyMgr := newPolicyManager()
 
@@ -52,6 +52,11 @@ type TLSContext struct {
 	// FromFile is true if the values in the keys above were read from the filesystem
 	// and not a Kubernetes Secret
 	FromFile bool
+	// PresentClientCert is true if the policy explicitly requested that the
+	// certificate and private key from the Secret are presented as a client
+	// certificate on connections originated by the proxy. Only set for
+	// originating TLS contexts.
+	PresentClientCert bool
 }
 
 // Equal returns true if 'a' and 'b' have the same contents.
@@ -973,6 +978,10 @@ func (l4 *L4Filter) getCerts(policyCtx PolicyContext, tls *api.TLSContext, direc
 		return nil, err
 	}
 
+	// A client certificate is only presented on originating connections when the
+	// policy explicitly selected one from the Secret.
+	presentClientCert := direction == OriginatingTLS && (tls.Certificate != "" || tls.PrivateKey != "")
+
 	// If the secret is not being included into NPDS inline, we're going to pass an SDS reference instead.
 	if inlineSecrets {
 		switch direction {
@@ -984,6 +993,9 @@ func (l4 *L4Filter) getCerts(policyCtx PolicyContext, tls *api.TLSContext, direc
 			if ca == "" {
 				return nil, fmt.Errorf("Originating TLS context is missing CA certs.")
 			}
+			if presentClientCert && (public == "" || private == "") {
+				return nil, fmt.Errorf("Originating TLS context is missing client certs.")
+			}
 		default:
 			return nil, fmt.Errorf("invalid TLS direction: %s", direction)
 		}
@@ -992,11 +1004,12 @@ func (l4 *L4Filter) getCerts(policyCtx PolicyContext, tls *api.TLSContext, direc
 	}
 
 	return &TLSContext{
-		TrustedCA:        ca,
-		CertificateChain: public,
-		PrivateKey:       private,
-		FromFile:         inlineSecrets,
-		Secret:           k8sTypes.NamespacedName(*tls.Secret),
+		TrustedCA:         ca,
+		CertificateChain:  public,
+		PrivateKey:        private,
+		FromFile:          inlineSecrets,
+		Secret:            k8sTypes.NamespacedName(*tls.Secret),
+		PresentClientCert: presentClientCert,
 	}, nil
 }
 
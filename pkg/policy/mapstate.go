@@ -4,8 +4,11 @@
 package policy
 
 import (
+	"fmt"
 	"iter"
 	"log/slog"
+	"strconv"
+	"strings"
 
 	"github.com/hashicorp/go-hclog"
 
@@ -18,6 +21,7 @@ import (
 	"github.com/cilium/cilium/pkg/option"
 	"github.com/cilium/cilium/pkg/policy/trafficdirection"
 	"github.com/cilium/cilium/pkg/policy/types"
+	"github.com/cilium/cilium/pkg/u8proto"
 )
 
 // Key and Keys are types used both internally and externally.
@@ -862,12 +866,55 @@ func (changes *ChangeState) insertOldIfNotExists(key Key, entry mapStateEntry) b
 // determineAllowLocalhostIngress determines whether communication should be allowed
 // from the localhost. It inserts the Key corresponding to the localhost in
 // the desiredPolicyKeys if the localhost is allowed to communicate with the
-// endpoint. Authentication for localhost traffic is not required.
+// endpoint. When --allow-localhost-ports is set, the implicit allow is
+// narrowed down to the configured port/protocol pairs (e.g. the kubelet
+// probe ports) instead of covering all ports. Authentication for localhost
+// traffic is not required.
 func (ms *mapState) determineAllowLocalhostIngress() {
 	if option.Config.AlwaysAllowLocalhost() {
 		entry := newAllowEntryWithLabels(LabelsLocalHostIngress)
-		ms.insertWithChanges(localHostKey, entry, allFeatures, ChangeState{})
+		if len(option.Config.AllowLocalhostPorts) == 0 {
+			ms.insertWithChanges(localHostKey, entry, allFeatures, ChangeState{})
+			return
+		}
+		for _, pp := range option.Config.AllowLocalhostPorts {
+			proto, port, err := parsePortProto(pp)
+			if err != nil {
+				// values are validated at agent startup
+				continue
+			}
+			ms.insertWithChanges(localHostKey.WithPortProto(proto, port), entry, allFeatures, ChangeState{})
+		}
+	}
+}
+
+// parsePortProto parses a "port[/protocol]" pair as accepted by
+// --allow-localhost-ports. The protocol defaults to TCP.
+func parsePortProto(pp string) (u8proto.U8proto, uint16, error) {
+	portStr, protoStr, found := strings.Cut(pp, "/")
+	proto := u8proto.TCP
+	if found {
+		var err error
+		proto, err = u8proto.ParseProtocol(protoStr)
+		if err != nil {
+			return 0, 0, err
+		}
+	}
+	port, err := strconv.ParseUint(portStr, 10, 16)
+	if err != nil || port == 0 {
+		return 0, 0, fmt.Errorf("invalid port %q", portStr)
+	}
+	return proto, uint16(port), nil
+}
+
+// ValidateAllowLocalhostPorts validates the value of --allow-localhost-ports.
+func ValidateAllowLocalhostPorts(portProtos []string) error {
+	for _, pp := range portProtos {
+		if _, _, err := parsePortProto(pp); err != nil {
+			return err
+		}
 	}
+	return nil
 }
 
 // allowAllIdentities translates all identities in selectorCache to their
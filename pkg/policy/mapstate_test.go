@@ -2034,3 +2034,29 @@ func TestDenyPreferredInsertLogic(t *testing.T) {
 	p.detach(true, 0)
 	assert.Positive(t, n)
 }
+
+func TestParsePortProto(t *testing.T) {
+	for _, tc := range []struct {
+		in        string
+		wantProto u8proto.U8proto
+		wantPort  uint16
+		wantErr   bool
+	}{
+		{in: "10250", wantProto: u8proto.TCP, wantPort: 10250},
+		{in: "10250/tcp", wantProto: u8proto.TCP, wantPort: 10250},
+		{in: "53/udp", wantProto: u8proto.UDP, wantPort: 53},
+		{in: "0", wantErr: true},
+		{in: "65536", wantErr: true},
+		{in: "http", wantErr: true},
+		{in: "80/invalid", wantErr: true},
+	} {
+		proto, port, err := parsePortProto(tc.in)
+		if tc.wantErr {
+			assert.Error(t, err, tc.in)
+			continue
+		}
+		require.NoError(t, err, tc.in)
+		assert.Equal(t, tc.wantProto, proto, tc.in)
+		assert.Equal(t, tc.wantPort, port, tc.in)
+	}
+}
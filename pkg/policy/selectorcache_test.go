@@ -16,6 +16,7 @@ import (
 	"github.com/cilium/cilium/pkg/container/versioned"
 	"github.com/cilium/cilium/pkg/identity"
 	k8sConst "github.com/cilium/cilium/pkg/k8s/apis/cilium.io"
+	slim_metav1 "github.com/cilium/cilium/pkg/k8s/slim/k8s/apis/meta/v1"
 	"github.com/cilium/cilium/pkg/labels"
 	"github.com/cilium/cilium/pkg/lock"
 	"github.com/cilium/cilium/pkg/policy/api"
@@ -339,6 +340,53 @@ func TestMultipleIdentitySelectors(t *testing.T) {
 	require.Empty(t, sc.selectors)
 }
 
+func TestClusterMatchExpressionSelectors(t *testing.T) {
+	sc := testNewSelectorCache(hivetest.Logger(t), identity.IdentityMap{})
+
+	clusterIdentity := func(cluster, app string) labels.LabelArray {
+		return labels.Labels{
+			k8sConst.PolicyLabelCluster: labels.NewLabel(k8sConst.PolicyLabelCluster, cluster, labels.LabelSourceK8s),
+			"app":                       labels.NewLabel("app", app, labels.LabelSourceK8s),
+		}.LabelArray()
+	}
+
+	// Add identities from three different clusters to the identity cache
+	wg := &sync.WaitGroup{}
+	sc.UpdateIdentities(identity.IdentityMap{
+		1234: clusterIdentity("berlin-1", "test"),
+		2345: clusterIdentity("berlin-2", "test"),
+		3456: clusterIdentity("paris-1", "test"),
+	}, nil, wg)
+	wg.Wait()
+
+	clusterExpression := func(op slim_metav1.LabelSelectorOperator, clusters ...string) api.EndpointSelector {
+		return api.NewESFromMatchRequirements(nil, []slim_metav1.LabelSelectorRequirement{{
+			Key:      labels.LabelSourceK8s + "." + k8sConst.PolicyLabelCluster,
+			Operator: op,
+			Values:   clusters,
+		}})
+	}
+
+	user1 := newUser(t, "user1", sc)
+	shouldSelect := func(sel api.EndpointSelector, wantIDs ...identity.NumericIdentity) {
+		csel := user1.AddIdentitySelector(sel)
+		selections := csel.GetSelections(versioned.Latest())
+		require.Equal(t, identity.NumericIdentitySlice(wantIDs), selections)
+		user1.RemoveSelector(csel)
+	}
+
+	// In/NotIn expressions on the cluster label are evaluated like any other
+	// label requirement, allowing sets of clusters to be selected without
+	// enumerating them in separate rules.
+	shouldSelect(clusterExpression(slim_metav1.LabelSelectorOpIn, "berlin-1", "berlin-2"), 1234, 2345)
+	shouldSelect(clusterExpression(slim_metav1.LabelSelectorOpIn, "paris-1"), 3456)
+	shouldSelect(clusterExpression(slim_metav1.LabelSelectorOpNotIn, "paris-1"), 1234, 2345)
+	shouldSelect(clusterExpression(slim_metav1.LabelSelectorOpIn, "oslo-1"))
+
+	// All selectors removed
+	require.Empty(t, sc.selectors)
+}
+
 func TestIdentityUpdates(t *testing.T) {
 	sc := testNewSelectorCache(hivetest.Logger(t), identity.IdentityMap{})
 
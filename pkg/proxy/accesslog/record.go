@@ -262,6 +262,10 @@ type LogRecordDNS struct {
 	// Query is the name in the original query
 	Query string `json:"Query,omitempty"`
 
+	// TransactionID is the DNS message ID, used to correlate queries with
+	// their responses.
+	TransactionID uint16 `json:"TransactionID,omitempty"`
+
 	// IPs are any IPs seen in this response.
 	// This field is filled only for DNS responses with IPs.
 	IPs []netip.Addr `json:"IPs,omitempty"`
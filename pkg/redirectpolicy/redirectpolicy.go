@@ -193,6 +193,10 @@ func getSanitizedLRPConfig(name, namespace string, uid types.UID, spec v2.Cilium
 		}
 		feMappings = make([]*feMapping, len(addrMatcher.ToPorts))
 		for i, portInfo := range addrMatcher.ToPorts {
+			if portInfo.SkipRedirectFromBackend != nil {
+				return nil, fmt.Errorf("per-port skipRedirectFromBackend is not " +
+					"supported with the legacy redirect policy implementation")
+			}
 			p, pName, proto, err := portInfo.SanitizePortInfo(checkNamedPort)
 			if err != nil {
 				return nil, fmt.Errorf("invalid address matcher port: %w", err)
@@ -231,6 +235,10 @@ func getSanitizedLRPConfig(name, namespace string, uid types.UID, spec v2.Cilium
 		}
 		feMappings = make([]*feMapping, len(svcMatcher.ToPorts))
 		for i, portInfo := range svcMatcher.ToPorts {
+			if portInfo.SkipRedirectFromBackend != nil {
+				return nil, fmt.Errorf("per-port skipRedirectFromBackend is not " +
+					"supported with the legacy redirect policy implementation")
+			}
 			p, pName, proto, err := portInfo.SanitizePortInfo(checkNamedPort)
 			if err != nil {
 				return nil, fmt.Errorf("invalid service matcher port: %w", err)
@@ -39,6 +39,7 @@ import (
 	"github.com/cilium/cilium/pkg/ipcache"
 	"github.com/cilium/cilium/pkg/lock"
 	"github.com/cilium/cilium/pkg/logging/logfields"
+	"github.com/cilium/cilium/pkg/metrics"
 	"github.com/cilium/cilium/pkg/mtu"
 	"github.com/cilium/cilium/pkg/node"
 	"github.com/cilium/cilium/pkg/option"
@@ -51,6 +52,22 @@ var (
 	wgDummyPeerKey = wgtypes.Key{}
 )
 
+const (
+	// peerMetricsInterval is the interval at which the WireGuard peer
+	// metrics are refreshed.
+	peerMetricsInterval = 1 * time.Minute
+
+	// staleHandshakeTimeout is the age of the last handshake after which a
+	// peer is considered stale. WireGuard rekeys an active session at the
+	// latest every two minutes, so a peer which completed a handshake
+	// before but has not re-handshaked for this long can no longer reach
+	// its remote endpoint, e.g. because a stateful firewall in between
+	// expired the UDP flow. Enabling --wireguard-persistent-keepalive
+	// keeps idle tunnels active and thus makes this signal meaningful for
+	// peers without constant traffic.
+	staleHandshakeTimeout = 5 * time.Minute
+)
+
 // wireguardClient is an interface to mock wgctrl.Client
 type wireguardClient interface {
 	io.Closer
@@ -256,6 +273,7 @@ func (a *Agent) Init(ipcache *ipcache.IPCache) error {
 	}
 
 	a.jobGroup.Add(job.OneShot("mtu-reconciler", a.mtuReconciler))
+	a.jobGroup.Add(job.Timer("peer-metrics", a.reportPeerMetrics, peerMetricsInterval))
 
 	// this is read by the defer statement above
 	addIPCacheListener = true
@@ -309,6 +327,28 @@ func (a *Agent) mtuReconciler(ctx context.Context, health cell.Health) error {
 	}
 }
 
+// reportPeerMetrics is a timer job that updates the WireGuard peer metrics
+// from the current device state.
+func (a *Agent) reportPeerMetrics(_ context.Context) error {
+	dev, err := a.wgClient.Device(types.IfaceName)
+	if err != nil {
+		return fmt.Errorf("failed to obtain WireGuard device: %w", err)
+	}
+
+	stale := 0
+	for _, p := range dev.Peers {
+		if p.Endpoint != nil && !p.LastHandshakeTime.IsZero() &&
+			time.Since(p.LastHandshakeTime) > staleHandshakeTimeout {
+			stale++
+		}
+	}
+
+	metrics.WireguardPeers.Set(float64(len(dev.Peers)))
+	metrics.WireguardStalePeerHandshakes.Set(float64(stale))
+
+	return nil
+}
+
 func (a *Agent) RestoreFinished(cm *clustermesh.ClusterMesh) error {
 	if cm != nil {
 		// Wait until we received the initial list of nodes from all remote clusters,